@@ -0,0 +1,8 @@
+# Derive a bandwidth threshold from the observed 95th percentile usage
+data "leaseweb_dedicated_server_bandwidth_metrics" "example" {
+  dedicated_server_id = "12345"
+  from                = "2025-01-01T00:00:00Z"
+  to                  = "2025-02-01T00:00:00Z"
+  granularity         = "DAY"
+  aggregation         = "95TH"
+}
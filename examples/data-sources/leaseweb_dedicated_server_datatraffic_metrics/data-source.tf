@@ -0,0 +1,8 @@
+# Show the average data traffic per day of a dedicated server
+data "leaseweb_dedicated_server_datatraffic_metrics" "example" {
+  dedicated_server_id = "12345"
+  from                = "2025-01-01T00:00:00Z"
+  to                  = "2025-02-01T00:00:00Z"
+  granularity         = "DAY"
+  aggregation         = "AVG"
+}
@@ -0,0 +1,4 @@
+# Show the hardware of a dedicated server
+data "leaseweb_dedicated_server_hardware" "example" {
+  dedicated_server_id = "12345"
+}
@@ -0,0 +1,2 @@
+# List all Dedicated server rescue images
+data "leaseweb_dedicated_server_rescue_images" "all" {}
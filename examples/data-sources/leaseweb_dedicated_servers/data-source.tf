@@ -1,3 +1,10 @@
 # List all Dedicated servers
 data "leaseweb_dedicated_servers" "all" {
 }
+
+# List the details of active servers with at least 64GB of ram
+data "leaseweb_dedicated_servers" "large" {
+  min_ram         = 64
+  contract_status = "ACTIVE"
+  details         = true
+}
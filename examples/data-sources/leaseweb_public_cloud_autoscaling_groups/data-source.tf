@@ -0,0 +1,13 @@
+# List all Public Cloud autoscaling groups
+data "leaseweb_public_cloud_autoscaling_groups" "all" {}
+
+# Get Public Cloud autoscaling groups filtered by instance
+data "leaseweb_public_cloud_autoscaling_groups" "example" {
+  instance_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+}
+
+# Get Public Cloud autoscaling groups filtered by type & state
+data "leaseweb_public_cloud_autoscaling_groups" "example2" {
+  type  = "CPU_BASED"
+  state = "ACTIVE"
+}
@@ -0,0 +1,13 @@
+# Get the costs of a Public Cloud instance for November 2023
+data "leaseweb_public_cloud_expenses" "example" {
+  equipment_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  from         = "2023-11-01"
+}
+
+# Fail when the monthly spend exceeds the budget
+check "budget" {
+  assert {
+    condition     = data.leaseweb_public_cloud_expenses.example.total <= 100
+    error_message = "Monthly spend exceeds the budget of 100."
+  }
+}
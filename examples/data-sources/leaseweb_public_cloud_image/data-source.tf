@@ -0,0 +1,11 @@
+# Get a Public Cloud image by name
+data "leaseweb_public_cloud_image" "example" {
+  name = "Ubuntu 24.04 LTS (x86_64)"
+}
+
+# Get the most recently created custom image whose name matches a regular expression
+data "leaseweb_public_cloud_image" "latest" {
+  name_regex  = "^web-server-"
+  custom      = true
+  most_recent = true
+}
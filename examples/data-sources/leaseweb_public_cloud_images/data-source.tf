@@ -1,2 +1,10 @@
 # List all Public Cloud images
 data "leaseweb_public_cloud_images" "all" {}
+
+# List all custom Ubuntu images that support central storage
+data "leaseweb_public_cloud_images" "ubuntu" {
+  name_regex   = "^Ubuntu"
+  flavour      = "ubuntu"
+  custom       = true
+  storage_type = "CENTRAL"
+}
@@ -0,0 +1,9 @@
+# Get VNC console access for a Public Cloud instance
+data "leaseweb_public_cloud_instance_console" "example" {
+  instance_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+}
+
+output "console_url" {
+  value     = data.leaseweb_public_cloud_instance_console.example.url
+  sensitive = true
+}
@@ -0,0 +1,13 @@
+# Get the CPU usage of a Public Cloud instance
+data "leaseweb_public_cloud_instance_metrics" "cpu" {
+  instance_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  metric      = "cpu"
+  from        = "2025-01-28"
+  to          = "2025-01-29"
+  granularity = "60m"
+}
+
+# Alert at 1.5 times the observed average CPU usage
+output "cpu_threshold" {
+  value = data.leaseweb_public_cloud_instance_metrics.cpu.series[0].avg * 1.5
+}
@@ -0,0 +1,17 @@
+# List all Public Cloud instance types available in a region
+data "leaseweb_public_cloud_instance_types" "example" {
+  region = "eu-west-3"
+}
+
+# Pick the cheapest instance type with at least 4 vCPUs
+locals {
+  large_instance_types = [
+    for instance_type in data.leaseweb_public_cloud_instance_types.example.instance_types :
+    instance_type if instance_type.cpu >= 4
+  ]
+
+  cheapest_instance_type = [
+    for instance_type in local.large_instance_types :
+    instance_type.name if tonumber(instance_type.hourly_price) == min([for t in local.large_instance_types : tonumber(t.hourly_price)]...)
+  ][0]
+}
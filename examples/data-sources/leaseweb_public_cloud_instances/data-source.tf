@@ -1,2 +1,15 @@
 # List all Public Cloud instances
 data "leaseweb_public_cloud_instances" "all" {}
+
+# Get running Public Cloud instances in a region
+data "leaseweb_public_cloud_instances" "example" {
+  region = "eu-west-3"
+  state  = "RUNNING"
+}
+
+# Get Public Cloud instances whose reference starts with "web-", without
+# retrieving the details of every instance
+data "leaseweb_public_cloud_instances" "example2" {
+  reference_regex = "^web-"
+  details         = false
+}
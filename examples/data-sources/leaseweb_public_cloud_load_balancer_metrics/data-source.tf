@@ -0,0 +1,8 @@
+# Get the data traffic of a Public Cloud load balancer
+data "leaseweb_public_cloud_load_balancer_metrics" "datatraffic" {
+  load_balancer_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  metric           = "datatraffic"
+  from             = "2025-01-28T00:00:00+00:00"
+  to               = "2025-01-29T00:00:00+00:00"
+  granularity      = "DAY"
+}
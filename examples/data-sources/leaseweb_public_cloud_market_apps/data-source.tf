@@ -0,0 +1,2 @@
+# List all Public Cloud market apps
+data "leaseweb_public_cloud_market_apps" "all" {}
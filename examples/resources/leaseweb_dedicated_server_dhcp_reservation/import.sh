@@ -0,0 +1,2 @@
+# Dedicated server DHCP reservation can be imported by specifying the dedicated server id.
+terraform import leaseweb_dedicated_server_dhcp_reservation.example 12345
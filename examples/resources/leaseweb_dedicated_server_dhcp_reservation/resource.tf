@@ -0,0 +1,6 @@
+# Boot a dedicated server from a custom PXE image
+resource "leaseweb_dedicated_server_dhcp_reservation" "example" {
+  dedicated_server_id = "12345"
+  bootfile            = "http://example.com/pxe/boot.ipxe"
+  hostname            = "server.example.com"
+}
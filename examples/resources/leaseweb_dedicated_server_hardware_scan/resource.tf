@@ -0,0 +1,7 @@
+# Scan the hardware of a dedicated server
+resource "leaseweb_dedicated_server_hardware_scan" "example" {
+  dedicated_server_id = "12345"
+  triggers = {
+    disks_replaced = "2024-01-01"
+  }
+}
@@ -0,0 +1,3 @@
+# Dedicated server installation can be imported by specifying <dedicated_server_id>,<installation job uuid>.
+# Options that are not part of the installation job, i.e. hostname or ssh_keys, can be set afterward without a reinstall.
+terraform import leaseweb_dedicated_server_installation.example 12345,c2b5b1e0-4a2f-4b7b-8f44-1e1a7b5e2a8d
@@ -0,0 +1,2 @@
+# Dedicated server network interface can be imported by specifying <dedicated_server_id>,<type>.
+terraform import leaseweb_dedicated_server_network_interface.example 12345,remote_management
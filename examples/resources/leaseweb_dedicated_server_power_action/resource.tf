@@ -0,0 +1,8 @@
+# Reboot a dedicated server after the installation has finished
+resource "leaseweb_dedicated_server_power_action" "example" {
+  dedicated_server_id = "12345"
+  action              = "reboot"
+  triggers = {
+    installation_id = leaseweb_dedicated_server_installation.example.id
+  }
+}
@@ -0,0 +1,2 @@
+# Dedicated server private network can be imported by specifying <dedicated_server_id>,<private_network_id>.
+terraform import leaseweb_dedicated_server_private_network.example 12345,811
@@ -0,0 +1,6 @@
+# Add a dedicated server to a private network
+resource "leaseweb_dedicated_server_private_network" "example" {
+  dedicated_server_id = "12345"
+  private_network_id  = "811"
+  link_speed          = 1000
+}
@@ -0,0 +1,12 @@
+# Boot a dedicated server into rescue mode
+resource "leaseweb_dedicated_server_rescue_mode" "example" {
+  dedicated_server_id = "12345"
+  rescue_image_id     = "GRML"
+  ssh_keys = [
+    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKzd1I5mJG6i6gkR8Lus1fTGJalNz5LOCwDBOmLhhIYq user@example.com",
+  ]
+  post_install_script = <<-EOS
+      #!/bin/sh
+      echo "rescue mode is ready"
+  EOS
+}
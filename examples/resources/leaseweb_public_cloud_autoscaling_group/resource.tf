@@ -0,0 +1,30 @@
+# Manage example Public Cloud manual autoscaling group
+resource "leaseweb_public_cloud_autoscaling_group" "manual" {
+  type           = "MANUAL"
+  instance_id    = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  reference      = "Manual autoscaling group"
+  desired_amount = 2
+}
+
+# Manage example Public Cloud scheduled autoscaling group
+resource "leaseweb_public_cloud_autoscaling_group" "scheduled" {
+  type           = "SCHEDULED"
+  instance_id    = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  reference      = "Scheduled autoscaling group"
+  desired_amount = 2
+  starts_at      = "2024-05-01T08:00:00Z"
+  ends_at        = "2024-05-01T12:00:00Z"
+}
+
+# Manage example Public Cloud CPU based autoscaling group linked to a target group
+resource "leaseweb_public_cloud_autoscaling_group" "cpu_based" {
+  type            = "CPU_BASED"
+  instance_id     = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  reference       = "CPU based autoscaling group"
+  minimum_amount  = 1
+  maximum_amount  = 3
+  cpu_threshold   = 50
+  warmup_time     = 300
+  cooldown_time   = 300
+  target_group_id = "c737e9e2-a1b7-4f06-af77-92fc62c0e4bd"
+}
@@ -2,4 +2,8 @@
 resource "leaseweb_public_cloud_image" "example" {
   instance_id = "396a3299-1795-464b-aa10-e1f179db1926"
   name        = "Custom image"
+
+  timeouts {
+    create = "90m"
+  }
 }
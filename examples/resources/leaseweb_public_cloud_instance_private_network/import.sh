@@ -0,0 +1,2 @@
+# Public Cloud instance_private_network can be imported by specifying <instance_id>
+terraform import leaseweb_public_cloud_instance_private_network.example 695ddd91-051f-4dd6-9120-938a927a47d0
@@ -0,0 +1,4 @@
+# Add a Public Cloud instance to the private network
+resource "leaseweb_public_cloud_instance_private_network" "example" {
+  instance_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+}
@@ -4,3 +4,12 @@ resource "leaseweb_public_cloud_ip" "example" {
   ip             = "10.0.0.1"
   reverse_lookup = "example.com"
 }
+
+# Null route an attacked Public Cloud ip
+resource "leaseweb_public_cloud_ip" "attacked" {
+  instance_id        = "695ddd91-051f-4dd6-9120-938a927a47d0"
+  ip                 = "10.0.0.2"
+  reverse_lookup     = "example.com"
+  null_routed        = true
+  null_route_comment = "DDoS attack"
+}
@@ -5,7 +5,8 @@ resource "leaseweb_public_cloud_load_balancer" "example" {
     term              = 0
     type              = "HOURLY"
   }
-  reference = "my webserver"
-  region    = "eu-west-3"
-  type      = "lsw.m3.large"
+  power_state = "RUNNING"
+  reference   = "my webserver"
+  region      = "eu-west-3"
+  type        = "lsw.m3.large"
 }
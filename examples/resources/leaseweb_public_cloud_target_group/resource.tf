@@ -33,3 +33,21 @@ resource "leaseweb_public_cloud_target_group" "example" {
   port     = 80
   region   = "eu-west-3"
 }
+
+# Manage example Public Cloud target group that waits for healthy targets after an update
+resource "leaseweb_public_cloud_target_group" "example" {
+  name     = "test"
+  protocol = "HTTP"
+  port     = 80
+  region   = "eu-west-3"
+  health_check = {
+    port     = 80
+    protocol = "HTTP"
+    method   = "GET"
+    uri      = "/health"
+  }
+  wait_for_healthy = {
+    minimum_healthy_targets = 2
+    timeout                 = "15m"
+  }
+}
@@ -0,0 +1,257 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &dhcpReservationResource{}
+	_ resource.ResourceWithConfigure   = &dhcpReservationResource{}
+	_ resource.ResourceWithImportState = &dhcpReservationResource{}
+)
+
+func NewDHCPReservationResource() resource.Resource {
+	return &dhcpReservationResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_dhcp_reservation",
+		},
+	}
+}
+
+type dhcpReservationResource struct {
+	utils.ResourceAPI
+}
+
+type dhcpReservationResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Bootfile          types.String `tfsdk:"bootfile"`
+	Hostname          types.String `tfsdk:"hostname"`
+	IP                types.String `tfsdk:"ip"`
+	MAC               types.String `tfsdk:"mac"`
+}
+
+// adaptLeaseToDHCPReservationResource keeps hostname if the lease has no
+// hostname, as the API does not always return it.
+func adaptLeaseToDHCPReservationResource(
+	dedicatedServerID types.String,
+	hostname types.String,
+	lease dedicatedserver.Lease,
+) dhcpReservationResourceModel {
+	if lease.GetHostname() != "" || hostname.IsUnknown() {
+		hostname = types.StringValue(lease.GetHostname())
+	}
+
+	return dhcpReservationResourceModel{
+		DedicatedServerID: dedicatedServerID,
+		Bootfile:          types.StringValue(lease.GetBootfile()),
+		Hostname:          hostname,
+		IP:                types.StringValue(lease.GetIp()),
+		MAC:               types.StringValue(lease.GetMac()),
+	}
+}
+
+func (d *dhcpReservationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DHCP reservation of a dedicated server. Do not combine this resource with the `dhcp_lease` attribute of `leaseweb_dedicated_server`, as both manage the same reservation.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bootfile": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of PXE boot you want your server to boot from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The hostname for the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ip address of the lease.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mac": schema.StringAttribute{
+				Computed:    true,
+				Description: "The mac address of the lease.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// getLease returns the active lease of the server or nil if the server
+// has none.
+func (d *dhcpReservationResource) getLease(
+	ctx context.Context,
+	serverID string,
+	diags *diag.Diagnostics,
+) *dedicatedserver.Lease {
+	result, response, err := d.DedicatedserverAPI.GetDhcpReservationList(
+		ctx,
+		serverID,
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return nil
+	}
+
+	leases := result.GetLeases()
+	if len(leases) == 0 {
+		return nil
+	}
+
+	return &leases[0]
+}
+
+func (d *dhcpReservationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan dhcpReservationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := plan.DedicatedServerID.ValueString()
+
+	opts := dedicatedserver.NewCreateDhcpReservationOpts(plan.Bootfile.ValueString())
+	opts.Hostname = utils.AdaptStringPointerValueToNullableString(plan.Hostname)
+	response, err := d.DedicatedserverAPI.CreateDhcpReservation(
+		ctx,
+		serverID,
+	).CreateDhcpReservationOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	lease := d.getLease(ctx, serverID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lease == nil {
+		resp.Diagnostics.AddError(
+			"DHCP reservation not found",
+			fmt.Sprintf("The DHCP reservation of dedicated server %s has not been created.", serverID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			adaptLeaseToDHCPReservationResource(
+				plan.DedicatedServerID,
+				plan.Hostname,
+				*lease,
+			),
+		)...,
+	)
+}
+
+func (d *dhcpReservationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state dhcpReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lease := d.getLease(ctx, state.DedicatedServerID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The reservation has been deleted outside of Terraform.
+	if lease == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			adaptLeaseToDHCPReservationResource(
+				state.DedicatedServerID,
+				state.Hostname,
+				*lease,
+			),
+		)...,
+	)
+}
+
+func (d *dhcpReservationResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (d *dhcpReservationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state dhcpReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.DedicatedserverAPI.DeleteDhcpReservation(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
+
+func (d *dhcpReservationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(
+		ctx,
+		path.Root("dedicated_server_id"),
+		req,
+		resp,
+	)
+}
@@ -0,0 +1,73 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptLeaseToDHCPReservationResource(t *testing.T) {
+	newLease := func(hostname string) dedicatedserver.Lease {
+		lease := dedicatedserver.Lease{}
+		lease.SetBootfile("http://example.com/boot.ipxe")
+		lease.SetHostname(hostname)
+		lease.SetIp("127.0.0.1")
+		lease.SetMac("AA:BB:CC:DD:EE:FF")
+
+		return lease
+	}
+
+	t.Run("values are set", func(t *testing.T) {
+		got := adaptLeaseToDHCPReservationResource(
+			basetypes.NewStringValue("12345"),
+			basetypes.NewStringValue("planned.example.com"),
+			newLease("server.example.com"),
+		)
+
+		want := dhcpReservationResourceModel{
+			DedicatedServerID: basetypes.NewStringValue("12345"),
+			Bootfile:          basetypes.NewStringValue("http://example.com/boot.ipxe"),
+			Hostname:          basetypes.NewStringValue("server.example.com"),
+			IP:                basetypes.NewStringValue("127.0.0.1"),
+			MAC:               basetypes.NewStringValue("AA:BB:CC:DD:EE:FF"),
+		}
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("hostname is kept if the lease has none", func(t *testing.T) {
+		got := adaptLeaseToDHCPReservationResource(
+			basetypes.NewStringValue("12345"),
+			basetypes.NewStringValue("planned.example.com"),
+			newLease(""),
+		)
+
+		assert.Equal(
+			t,
+			basetypes.NewStringValue("planned.example.com"),
+			got.Hostname,
+		)
+	})
+
+	t.Run("hostname is null if the lease has none and none is known", func(t *testing.T) {
+		got := adaptLeaseToDHCPReservationResource(
+			basetypes.NewStringValue("12345"),
+			basetypes.NewStringNull(),
+			newLease(""),
+		)
+
+		assert.True(t, got.Hostname.IsNull())
+	})
+
+	t.Run("unknown hostname is set from the lease", func(t *testing.T) {
+		got := adaptLeaseToDHCPReservationResource(
+			basetypes.NewStringValue("12345"),
+			basetypes.NewStringUnknown(),
+			newLease(""),
+		)
+
+		assert.Equal(t, basetypes.NewStringValue(""), got.Hostname)
+	})
+}
@@ -0,0 +1,285 @@
+package dedicatedserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &hardwareDataSource{}
+	_ datasource.DataSourceWithConfigure = &hardwareDataSource{}
+)
+
+type hardwareDataSource struct {
+	utils.DataSourceAPI
+}
+
+type hardwareDiskDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	Product      types.String `tfsdk:"product"`
+	Vendor       types.String `tfsdk:"vendor"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	Size         types.String `tfsdk:"size"`
+}
+
+type hardwareControllerDataSourceModel struct {
+	Description types.String `tfsdk:"description"`
+	Product     types.String `tfsdk:"product"`
+	Vendor      types.String `tfsdk:"vendor"`
+}
+
+type hardwareNetworkInterfaceDataSourceModel struct {
+	LogicalName types.String `tfsdk:"logical_name"`
+	MACAddress  types.String `tfsdk:"mac_address"`
+	Product     types.String `tfsdk:"product"`
+	Vendor      types.String `tfsdk:"vendor"`
+	Speed       types.String `tfsdk:"speed"`
+}
+
+type hardwareIPMIDataSourceModel struct {
+	Firmware   types.String `tfsdk:"firmware"`
+	Vendor     types.String `tfsdk:"vendor"`
+	Version    types.String `tfsdk:"version"`
+	IPAddress  types.String `tfsdk:"ip_address"`
+	MACAddress types.String `tfsdk:"mac_address"`
+}
+
+type hardwareDataSourceModel struct {
+	DedicatedServerID types.String                              `tfsdk:"dedicated_server_id"`
+	ID                types.String                              `tfsdk:"id"`
+	ParserVersion     types.String                              `tfsdk:"parser_version"`
+	ScannedAt         types.String                              `tfsdk:"scanned_at"`
+	Disks             []hardwareDiskDataSourceModel             `tfsdk:"disks"`
+	Controllers       []hardwareControllerDataSourceModel       `tfsdk:"controllers"`
+	NetworkInterfaces []hardwareNetworkInterfaceDataSourceModel `tfsdk:"network_interfaces"`
+	IPMI              *hardwareIPMIDataSourceModel              `tfsdk:"ipmi"`
+}
+
+func (h *hardwareDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config hardwareDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, response, err := h.DedicatedserverAPI.GetHardware(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	hardware := result.GetResult()
+
+	var disks []hardwareDiskDataSourceModel
+	for _, disk := range hardware.GetDisks() {
+		disks = append(disks, hardwareDiskDataSourceModel{
+			ID:           types.StringValue(disk.GetId()),
+			Description:  types.StringValue(disk.GetDescription()),
+			Product:      types.StringValue(disk.GetProduct()),
+			Vendor:       types.StringValue(disk.GetVendor()),
+			SerialNumber: types.StringValue(disk.GetSerialNumber()),
+			Size:         types.StringValue(disk.GetSize()),
+		})
+	}
+
+	var controllers []hardwareControllerDataSourceModel
+	for _, controller := range hardware.GetControllers() {
+		controllers = append(controllers, hardwareControllerDataSourceModel{
+			Description: types.StringValue(controller.GetDescription()),
+			Product:     types.StringValue(controller.GetProduct()),
+			Vendor:      types.StringValue(controller.GetVendor()),
+		})
+	}
+
+	var networkInterfaces []hardwareNetworkInterfaceDataSourceModel
+	for _, networkInterface := range hardware.GetNetwork() {
+		settings := networkInterface.GetSettings()
+		networkInterfaces = append(
+			networkInterfaces,
+			hardwareNetworkInterfaceDataSourceModel{
+				LogicalName: types.StringValue(networkInterface.GetLogicalName()),
+				MACAddress:  types.StringValue(networkInterface.GetMacAddress()),
+				Product:     types.StringValue(networkInterface.GetProduct()),
+				Vendor:      types.StringValue(networkInterface.GetVendor()),
+				Speed:       types.StringValue(settings.GetSpeed()),
+			},
+		)
+	}
+
+	var ipmi *hardwareIPMIDataSourceModel
+	if sdkIPMI, ok := hardware.GetIpmiOk(); ok {
+		ipmi = &hardwareIPMIDataSourceModel{
+			Firmware:   types.StringValue(sdkIPMI.GetFirmware()),
+			Vendor:     types.StringValue(sdkIPMI.GetVendor()),
+			Version:    types.StringValue(sdkIPMI.GetVersion()),
+			IPAddress:  types.StringValue(sdkIPMI.GetIpAddress()),
+			MACAddress: types.StringValue(sdkIPMI.GetMacAddress()),
+		}
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			hardwareDataSourceModel{
+				DedicatedServerID: config.DedicatedServerID,
+				ID:                types.StringValue(result.GetId()),
+				ParserVersion:     types.StringValue(result.GetParserVersion()),
+				ScannedAt:         types.StringValue(result.GetScannedAt().Format(time.RFC3339)),
+				Disks:             disks,
+				Controllers:       controllers,
+				NetworkInterfaces: networkInterfaces,
+				IPMI:              ipmi,
+			},
+		)...,
+	)
+}
+
+func (h *hardwareDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the hardware scan.",
+			},
+			"parser_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the parser that processed the hardware scan.",
+			},
+			"scanned_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the hardware scan.",
+			},
+			"disks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the disk.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the disk.",
+						},
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "The model of the disk.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The vendor of the disk.",
+						},
+						"serial_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "The serial number of the disk.",
+						},
+						"size": schema.StringAttribute{
+							Computed:    true,
+							Description: "The size of the disk.",
+						},
+					},
+				},
+			},
+			"controllers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the controller.",
+						},
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "The model of the controller.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The vendor of the controller.",
+						},
+					},
+				},
+			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"logical_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the interface in the operating system.",
+						},
+						"mac_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Mac address of the interface.",
+						},
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "The model of the interface.",
+						},
+						"vendor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The vendor of the interface.",
+						},
+						"speed": schema.StringAttribute{
+							Computed:    true,
+							Description: "The link speed of the interface.",
+						},
+					},
+				},
+			},
+			"ipmi": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"firmware": schema.StringAttribute{
+						Computed:    true,
+						Description: "The firmware of the IPMI controller.",
+					},
+					"vendor": schema.StringAttribute{
+						Computed:    true,
+						Description: "The vendor of the IPMI controller.",
+					},
+					"version": schema.StringAttribute{
+						Computed:    true,
+						Description: "The IPMI version.",
+					},
+					"ip_address": schema.StringAttribute{
+						Computed:    true,
+						Description: "Ip address of the IPMI controller.",
+					},
+					"mac_address": schema.StringAttribute{
+						Computed:    true,
+						Description: "Mac address of the IPMI controller.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewHardwareDataSource() datasource.DataSource {
+	return &hardwareDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_hardware",
+		},
+	}
+}
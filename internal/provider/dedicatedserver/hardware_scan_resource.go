@@ -0,0 +1,183 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource              = &hardwareScanResource{}
+	_ resource.ResourceWithConfigure = &hardwareScanResource{}
+)
+
+func NewHardwareScanResource() resource.Resource {
+	return &hardwareScanResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_hardware_scan",
+		},
+	}
+}
+
+type hardwareScanResource struct {
+	utils.ResourceAPI
+}
+
+type hardwareScanResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	CallbackURL       types.String `tfsdk:"callback_url"`
+	PowerCycle        types.Bool   `tfsdk:"power_cycle"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+}
+
+func (h *hardwareScanResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the hardware scan job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Description: "Url which will receive callbacks when the hardware scan is finished or failed",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_cycle": schema.BoolAttribute{
+				Description: "If true, the server is rebooted automatically to run the hardware scan and Terraform waits until the scan has finished. Otherwise, you should reboot it manually and Terraform does not wait for the hardware scan job to finish. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will run a new hardware scan.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.UpdateAction, utils.DeleteAction},
+	)
+}
+
+func (h *hardwareScanResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan hardwareScanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := dedicatedserver.NewScanHardwareOpts()
+	opts.CallbackUrl = utils.AdaptStringPointerValueToNullableString(plan.CallbackURL)
+	opts.PowerCycle = utils.AdaptBoolPointerValueToNullableBool(plan.PowerCycle)
+
+	serverID := plan.DedicatedServerID.ValueString()
+	result, response, err := h.DedicatedserverAPI.ScanHardware(ctx, serverID).
+		ScanHardwareOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.ID = types.StringValue(result.GetUuid())
+
+	// Without a power cycle the job only finishes once the server has been
+	// rebooted manually, so there is nothing to wait for.
+	if plan.PowerCycle.ValueBool() {
+		waitForJob(
+			ctx,
+			h.DedicatedserverAPI,
+			serverID,
+			result.GetUuid(),
+			"Hardware scan",
+			&resp.Diagnostics,
+		)
+	}
+
+	// The state is also set if the scan failed, so the resource is tainted
+	// and the next apply runs the scan again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (h *hardwareScanResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state hardwareScanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, response, err := h.DedicatedserverAPI.GetJob(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (h *hardwareScanResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+// Delete only removes the resource from the state as a hardware scan
+// cannot be undone.
+func (h *hardwareScanResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
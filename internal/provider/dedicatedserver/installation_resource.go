@@ -3,16 +3,21 @@ package dedicatedserver
 import (
 	"context"
 	"encoding/base64"
+	"net/http"
+	"slices"
 	"strings"
 
-	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,8 +29,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &installationResource{}
-	_ resource.ResourceWithConfigure = &installationResource{}
+	_ resource.Resource                = &installationResource{}
+	_ resource.ResourceWithConfigure   = &installationResource{}
+	_ resource.ResourceWithImportState = &installationResource{}
 )
 
 func NewInstallationResource() resource.Resource {
@@ -55,6 +61,7 @@ type installationResourceModel struct {
 	Raid              types.Object   `tfsdk:"raid"`
 	SSHKeys           []types.String `tfsdk:"ssh_keys"`
 	Timezone          types.String   `tfsdk:"timezone"`
+	CancelOnDestroy   types.Bool     `tfsdk:"cancel_on_destroy"`
 }
 
 type raidResourceModel struct {
@@ -69,6 +76,147 @@ type partitionsResourceModel struct {
 	Size       types.String `tfsdk:"size"`
 }
 
+func adaptPartitionsToListValue(
+	ctx context.Context,
+	partitions []partitionsResourceModel,
+) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(
+		ctx,
+		types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"filesystem": types.StringType,
+				"mountpoint": types.StringType,
+				"size":       types.StringType,
+			},
+		},
+		partitions,
+	)
+}
+
+// importedPrivateStateKey marks installations that have been imported. The
+// installation options that are not part of the job payload are unknown
+// for these, so setting them afterward must not cause a reinstall.
+const importedPrivateStateKey = "imported"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// requiresReplaceUnlessImported returns false if the attribute was not
+// known when the installation was imported.
+func requiresReplaceUnlessImported(
+	ctx context.Context,
+	private privateStateGetter,
+	stateValue attr.Value,
+	diags *diag.Diagnostics,
+) bool {
+	if !stateValue.IsNull() {
+		return true
+	}
+
+	imported, privateDiags := private.GetKey(ctx, importedPrivateStateKey)
+	diags.Append(privateDiags...)
+
+	return imported == nil
+}
+
+const requiresReplaceUnlessImportedDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the resource was imported without this attribute."
+
+func stringRequiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.StringRequest,
+			resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = requiresReplaceUnlessImported(
+				ctx,
+				req.Private,
+				req.StateValue,
+				&resp.Diagnostics,
+			)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func int32RequiresReplaceUnlessImported() planmodifier.Int32 {
+	return int32planmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.Int32Request,
+			resp *int32planmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = requiresReplaceUnlessImported(
+				ctx,
+				req.Private,
+				req.StateValue,
+				&resp.Diagnostics,
+			)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func setRequiresReplaceUnlessImported() planmodifier.Set {
+	return setplanmodifier.RequiresReplaceIf(
+		func(
+			ctx context.Context,
+			req planmodifier.SetRequest,
+			resp *setplanmodifier.RequiresReplaceIfFuncResponse,
+		) {
+			resp.RequiresReplace = requiresReplaceUnlessImported(
+				ctx,
+				req.Private,
+				req.StateValue,
+				&resp.Diagnostics,
+			)
+		},
+		requiresReplaceUnlessImportedDescription,
+		requiresReplaceUnlessImportedDescription,
+	)
+}
+
+func (i *installationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,id",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("id"),
+		idParts[1],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("cancel_on_destroy"),
+		false,
+	)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(
+		ctx,
+		importedPrivateStateKey,
+		[]byte("true"),
+	)...)
+}
+
 func (i *installationResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -85,14 +233,14 @@ func (i *installationResource) Schema(
 						int32validator.OneOf([]int32{0, 1, 5, 10}...),
 					},
 					PlanModifiers: []planmodifier.Int32{
-						int32planmodifier.RequiresReplace(),
+						int32RequiresReplaceUnlessImported(),
 					},
 				},
 				"number_of_disks": schema.Int32Attribute{
 					Description: "The number of disks you want to apply RAID on. If not specified all disks are used",
 					Optional:    true,
 					PlanModifiers: []planmodifier.Int32{
-						int32planmodifier.RequiresReplace(),
+						int32RequiresReplaceUnlessImported(),
 					},
 				},
 				"type": schema.StringAttribute{
@@ -102,7 +250,7 @@ func (i *installationResource) Schema(
 						stringvalidator.OneOf([]string{"HW", "SW", "NONE"}...),
 					},
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+						stringRequiresReplaceUnlessImported(),
 					},
 				},
 			},
@@ -113,6 +261,10 @@ func (i *installationResource) Schema(
 		return schema.ListNestedAttribute{
 			Optional: true,
 			Computed: true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+				listplanmodifier.RequiresReplace(),
+			},
 			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
 					"filesystem": schema.StringAttribute{
@@ -120,6 +272,7 @@ func (i *installationResource) Schema(
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
 							stringplanmodifier.RequiresReplace(),
 						},
 					},
@@ -128,6 +281,7 @@ func (i *installationResource) Schema(
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
 							stringplanmodifier.RequiresReplace(),
 						},
 					},
@@ -136,6 +290,7 @@ func (i *installationResource) Schema(
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
 							stringplanmodifier.RequiresReplace(),
 						},
 					},
@@ -145,6 +300,7 @@ func (i *installationResource) Schema(
 	}
 
 	resp.Schema = schema.Schema{
+		Description: "Installs an operating system on a dedicated server. Changing any installation option reinstalls the operating system, only cancel_on_destroy is updated in place. Options that are not part of the installation job, i.e. hostname or ssh_keys, can be set after an import without a reinstall. Destroying the resource keeps the installed operating system and only cancels a running installation if cancel_on_destroy is set.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier of the installation job",
@@ -164,14 +320,14 @@ func (i *installationResource) Schema(
 				Description: "Url which will receive callbacks when the installation is finished or failed",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringRequiresReplaceUnlessImported(),
 				},
 			},
 			"control_panel_id": schema.StringAttribute{
 				Description: "Control panel identifier",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringRequiresReplaceUnlessImported(),
 				},
 			},
 			"device": schema.StringAttribute{
@@ -179,6 +335,7 @@ func (i *installationResource) Schema(
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -186,7 +343,7 @@ func (i *installationResource) Schema(
 				Description: "Hostname to be used in your installation",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringRequiresReplaceUnlessImported(),
 				},
 			},
 			"operating_system_id": schema.StringAttribute{
@@ -201,14 +358,14 @@ func (i *installationResource) Schema(
 				Description: "Server root password. If not provided, it would be automatically generated",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringRequiresReplaceUnlessImported(),
 				},
 			},
 			"post_install_script": schema.StringAttribute{
 				Description: "A valid bash script to run right after the installation.",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringRequiresReplaceUnlessImported(),
 				},
 			},
 			"power_cycle": schema.BoolAttribute{
@@ -216,6 +373,7 @@ func (i *installationResource) Schema(
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
@@ -225,7 +383,7 @@ func (i *installationResource) Schema(
 				Optional:    true,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Set{
-					setplanmodifier.RequiresReplace(),
+					setRequiresReplaceUnlessImported(),
 				},
 			},
 			"timezone": schema.StringAttribute{
@@ -233,16 +391,18 @@ func (i *installationResource) Schema(
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"cancel_on_destroy": schema.BoolAttribute{
+				Description: "If true, destroying the resource cancels the installation if it is still running. Otherwise destroying the resource only removes it from the state",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
-
-	utils.AddUnsupportedActionsNotation(
-		resp,
-		[]utils.Action{utils.ReadAction, utils.UpdateAction, utils.DeleteAction},
-	)
 }
 
 func (i *installationResource) Create(
@@ -339,93 +499,176 @@ func (i *installationResource) Create(
 	plan.Timezone = types.StringValue(payload.GetTimezone())
 	plan.PowerCycle = types.BoolValue(payload.GetPowerCycle())
 
-	partitionAttributeTypes := map[string]attr.Type{
-		"filesystem": types.StringType,
-		"mountpoint": types.StringType,
-		"size":       types.StringType,
-	}
-
-	// Preparing and converting partitions into types.Object to store in the state
-	var partitionsObjects []attr.Value
+	// Preparing and converting partitions into a types.List to store in the state
+	var partitionsModels []partitionsResourceModel
 	for _, p := range payload.GetPartitions() {
-		partition := partitionsResourceModel{
+		partitionsModels = append(partitionsModels, partitionsResourceModel{
 			Filesystem: types.StringValue(p.GetFilesystem()),
 			Mountpoint: types.StringValue(p.GetMountpoint()),
 			Size:       types.StringValue(p.GetSize()),
-		}
-
-		partitionObj, diags := types.ObjectValueFrom(
-			ctx,
-			partitionAttributeTypes,
-			partition,
-		)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-
-		partitionsObjects = append(partitionsObjects, partitionObj)
+		})
 	}
 
-	// Convert the slice of partition objects to a types.List and store it in the plan
-	partitionsList, diags := types.ListValueFrom(
-		ctx,
-		types.ObjectType{
-			AttrTypes: partitionAttributeTypes,
-		},
-		partitionsObjects,
-	)
+	partitionsList, diags := adaptPartitionsToListValue(ctx, partitionsModels)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 	plan.Partitions = partitionsList
 
-	pollJobStatus := func() (string, error) {
-		return getJobStatus(serverID, result.GetUuid(), i, ctx, resp)
+	waitForJob(
+		ctx,
+		i.DedicatedserverAPI,
+		serverID,
+		result.GetUuid(),
+		"Installation",
+		&resp.Diagnostics,
+	)
+
+	// The state is also set if the installation failed, so the resource is
+	// tainted and the next apply retries the installation.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read detects reinstalls that happened outside of Terraform by comparing
+// the job in state with the latest installation job of the server.
+func (i *installationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state installationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	_, err = backoff.Retry(context.TODO(), pollJobStatus, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	serverID := state.DedicatedServerID.ValueString()
+
+	job, response, err := i.DedicatedserverAPI.GetJob(
+		ctx,
+		serverID,
+		state.ID.ValueString(),
+	).Execute()
 	if err != nil {
-		utils.GeneralError(&resp.Diagnostics, ctx, err)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
+	// Imported installations only have an id & dedicated_server_id.
+	if state.OperatingSystemID.IsNull() {
+		payload := job.GetPayload()
+		state.OperatingSystemID = types.StringValue(payload.GetOperatingSystemId())
+		state.Device = types.StringValue(payload.GetDevice())
+		state.Timezone = types.StringValue(payload.GetTimezone())
+		state.PowerCycle = types.BoolValue(payload.GetPowerCycle())
+
+		var partitionsModels []partitionsResourceModel
+		for _, p := range payload.GetPartitions() {
+			partitionsModels = append(partitionsModels, partitionsResourceModel{
+				Filesystem: types.StringValue(p.GetFilesystem()),
+				Mountpoint: types.StringValue(p.GetMountpoint()),
+				Size:       types.StringValue(p.GetSize()),
+			})
+		}
 
-func getJobStatus(serverID string, jobID string, i *installationResource, ctx context.Context, resp *resource.CreateResponse) (string, error) {
-	request := i.DedicatedserverAPI.GetJob(ctx, serverID, jobID)
+		partitionsList, diags := adaptPartitionsToListValue(ctx, partitionsModels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Partitions = partitionsList
+	}
 
-	result, response, err := request.Execute()
+	// Jobs are returned newest first.
+	jobs, response, err := i.DedicatedserverAPI.GetJobList(ctx, serverID).
+		Type("install").
+		Execute()
 	if err != nil {
 		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
 	}
 
-	status := result.GetStatus()
-	if status != "FINISHED" {
-		return "", backoff.RetryAfter(30)
-	}
+	// The server has been reinstalled outside of Terraform. Tracking the new
+	// job causes a reinstall to be planned if the operating system differs
+	// from the configured one. Installations that failed or were canceled
+	// have not changed the operating system, so they are skipped.
+	for _, latestJob := range jobs.GetJobs() {
+		if latestJob.GetUuid() == state.ID.ValueString() {
+			break
+		}
+		if slices.Contains(failedJobStatuses, latestJob.GetStatus()) {
+			continue
+		}
 
-	return status, nil
-}
+		payload := latestJob.GetPayload()
+		state.ID = types.StringValue(latestJob.GetUuid())
+		state.OperatingSystemID = types.StringValue(payload.GetOperatingSystemId())
+		break
+	}
 
-func (i *installationResource) Read(
-	_ context.Context,
-	_ resource.ReadRequest,
-	_ *resource.ReadResponse,
-) {
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
+// Update only stores cancel_on_destroy and the attributes set after an
+// import, as all other changes require the operating system to be
+// reinstalled.
 func (i *installationResource) Update(
-	_ context.Context,
-	_ resource.UpdateRequest,
-	_ *resource.UpdateResponse,
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
 ) {
+	var plan installationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// Delete leaves the installed operating system as is. Running
+// installations are only canceled if cancel_on_destroy is set.
 func (i *installationResource) Delete(
-	_ context.Context,
-	_ resource.DeleteRequest,
-	_ *resource.DeleteResponse,
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
 ) {
+	var state installationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.CancelOnDestroy.ValueBool() {
+		return
+	}
+
+	serverID := state.DedicatedServerID.ValueString()
+
+	job, response, err := i.DedicatedserverAPI.GetJob(
+		ctx,
+		serverID,
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	if !job.GetIsRunning() {
+		return
+	}
+
+	_, response, err = i.DedicatedserverAPI.CancelActiveJob(ctx, serverID).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
 }
@@ -0,0 +1,97 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+const jobStatusFinished = "FINISHED"
+
+// failedJobStatuses are the statuses of jobs that will never finish.
+var failedJobStatuses = []string{"FAILED", "CANCELED", "EXPIRED"}
+
+// findFailedTask returns the first task of the job that did not succeed.
+func findFailedTask(tasks []dedicatedserver.Task) *dedicatedserver.Task {
+	for _, task := range tasks {
+		if slices.Contains(failedJobStatuses, task.GetStatus()) {
+			return &task
+		}
+	}
+
+	return nil
+}
+
+// getJobStatus returns an error as long as the job is running. Jobs that
+// will never finish return a permanent error & the failing task is
+// reported in diags.
+func getJobStatus(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	jobID string,
+	jobName string,
+	diags *diag.Diagnostics,
+) (string, error) {
+	request := api.GetJob(ctx, serverID, jobID)
+
+	result, response, err := request.Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return "", backoff.Permanent(err)
+	}
+
+	status := result.GetStatus()
+	if slices.Contains(failedJobStatuses, status) {
+		detail := fmt.Sprintf("%s job %s has status %s.", jobName, jobID, status)
+		if task := findFailedTask(result.GetTasks()); task != nil {
+			detail = fmt.Sprintf(
+				"%s Task %q has status %s: %s",
+				detail,
+				task.GetDescription(),
+				task.GetStatus(),
+				task.GetErrorMessage(),
+			)
+		}
+		diags.AddError(jobName+" failed", detail)
+
+		return status, backoff.Permanent(
+			fmt.Errorf("job %s has status %s", jobID, status),
+		)
+	}
+
+	if status != jobStatusFinished {
+		return "", backoff.RetryAfter(30)
+	}
+
+	return status, nil
+}
+
+// waitForJob polls the job until it is finished. jobName is used to
+// describe the job in diags, i.e. "Installation".
+func waitForJob(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	jobID string,
+	jobName string,
+	diags *diag.Diagnostics,
+) {
+	pollJobStatus := func() (string, error) {
+		return getJobStatus(ctx, api, serverID, jobID, jobName, diags)
+	}
+
+	_, err := backoff.Retry(
+		ctx,
+		pollJobStatus,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+}
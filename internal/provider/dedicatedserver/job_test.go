@@ -0,0 +1,181 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTask(description string, status string) dedicatedserver.Task {
+	task := dedicatedserver.Task{}
+	task.SetDescription(description)
+	task.SetStatus(status)
+	task.SetErrorMessage("The connection with the DHCP server could not be established.")
+
+	return task
+}
+
+// newJobTestAPI returns an api that responds to every request with the job
+// in the passed status. The job has a single task with the same status.
+func newJobTestAPI(t *testing.T, status string) dedicatedserver.DedicatedserverAPI {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasSuffix(r.URL.Path, "/servers/12345/jobs/jobID") {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = fmt.Fprint(w, `{"errorCode":"404","errorMessage":"Resource not found"}`)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{
+  "createdAt": "2018-01-09T10:38:12+00:00",
+  "flow": "tasks",
+  "isRunning": false,
+  "node": "80:18:44:E0:AF:C4!JGNTQ92",
+  "payload": {},
+  "progress": {
+    "canceled": 0,
+    "expired": 0,
+    "failed": 0,
+    "finished": 1,
+    "inprogress": 0,
+    "pending": 0,
+    "percentage": 100,
+    "total": 1,
+    "waiting": 0
+  },
+  "serverId": "12345",
+  "status": %[1]q,
+  "tasks": [
+    {
+      "description": "Configure DHCP",
+      "errorMessage": "The connection with the DHCP server could not be established.",
+      "flow": "tasks",
+      "onError": "break",
+      "status": %[1]q,
+      "statusTimestamps": {},
+      "uuid": "c77d8a6b-d255-4744-8b95-8bf4af6f8b48"
+    }
+  ],
+  "type": "install",
+  "updatedAt": "2018-01-09T10:38:12+00:00",
+  "uuid": "jobID"
+}`, status)
+		},
+	))
+	t.Cleanup(server.Close)
+
+	cfg := dedicatedserver.NewConfiguration()
+	cfg.Host = strings.TrimPrefix(server.URL, "http://")
+	cfg.Scheme = "http"
+
+	return dedicatedserver.NewAPIClient(cfg).DedicatedserverAPI
+}
+
+func Test_findFailedTask(t *testing.T) {
+	t.Run("first failed task is returned", func(t *testing.T) {
+		got := findFailedTask([]dedicatedserver.Task{
+			newTask("Power cycle", jobStatusFinished),
+			newTask("Configure DHCP", "FAILED"),
+			newTask("Install", "CANCELED"),
+		})
+
+		assert.NotNil(t, got)
+		assert.Equal(t, "Configure DHCP", got.GetDescription())
+	})
+
+	t.Run("nil is returned if no task failed", func(t *testing.T) {
+		got := findFailedTask([]dedicatedserver.Task{
+			newTask("Power cycle", jobStatusFinished),
+			newTask("Configure DHCP", "PENDING"),
+		})
+
+		assert.Nil(t, got)
+	})
+}
+
+func Test_getJobStatus(t *testing.T) {
+	t.Run("finished job is returned", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got, err := getJobStatus(
+			context.TODO(),
+			newJobTestAPI(t, jobStatusFinished),
+			"12345",
+			"jobID",
+			"Installation",
+			&diags,
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, jobStatusFinished, got)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("running job is retried", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		_, err := getJobStatus(
+			context.TODO(),
+			newJobTestAPI(t, "ACTIVE"),
+			"12345",
+			"jobID",
+			"Installation",
+			&diags,
+		)
+
+		var retryAfterError *backoff.RetryAfterError
+		assert.ErrorAs(t, err, &retryAfterError)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("failed job is not retried and reports the failed task", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got, err := getJobStatus(
+			context.TODO(),
+			newJobTestAPI(t, "FAILED"),
+			"12345",
+			"jobID",
+			"Installation",
+			&diags,
+		)
+
+		var permanentError *backoff.PermanentError
+		assert.ErrorAs(t, err, &permanentError)
+		assert.Equal(t, "FAILED", got)
+		assert.Equal(t, "Installation failed", diags.Errors()[0].Summary())
+		assert.Contains(
+			t,
+			diags.Errors()[0].Detail(),
+			`Task "Configure DHCP" has status FAILED: The connection with the DHCP server could not be established.`,
+		)
+	})
+
+	t.Run("api errors are not retried", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		_, err := getJobStatus(
+			context.TODO(),
+			newJobTestAPI(t, jobStatusFinished),
+			"12345",
+			"unknownJobID",
+			"Installation",
+			&diags,
+		)
+
+		var permanentError *backoff.PermanentError
+		assert.ErrorAs(t, err, &permanentError)
+		assert.True(t, diags.HasError())
+	})
+}
@@ -0,0 +1,232 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &metricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricsDataSource{}
+)
+
+var (
+	metricsGranularities = []string{"5MIN", "HOUR", "DAY", "WEEK", "MONTH", "YEAR"}
+	metricsAggregations  = []string{"AVG", "95TH"}
+)
+
+type metricsDataSourceModel struct {
+	DedicatedServerID types.String                         `tfsdk:"dedicated_server_id"`
+	From              types.String                         `tfsdk:"from"`
+	To                types.String                         `tfsdk:"to"`
+	Granularity       types.String                         `tfsdk:"granularity"`
+	Aggregation       types.String                         `tfsdk:"aggregation"`
+	Series            []utils.MetricsSeriesDataSourceModel `tfsdk:"series"`
+}
+
+func adaptMetricToMetricsSeries(
+	name string,
+	metric *dedicatedserver.Metric,
+) utils.MetricsSeriesDataSourceModel {
+	var values []utils.MetricsValueDataSourceModel
+	var unit string
+
+	if metric != nil {
+		for _, metricValue := range metric.GetValues() {
+			values = append(values, utils.MetricsValueDataSourceModel{
+				Timestamp: basetypes.NewStringValue(metricValue.GetTimestamp().UTC().Format(time.RFC3339)),
+				Value:     basetypes.NewFloat64Value(float64(metricValue.GetValue())),
+			})
+		}
+		unit = metric.GetUnit()
+	}
+
+	return utils.NewMetricsSeries(name, basetypes.NewStringValue(unit), values)
+}
+
+// parseMetricsInterval parses from & to. Errors are added to diags.
+func parseMetricsInterval(
+	config metricsDataSourceModel,
+	diags *diag.Diagnostics,
+) (from time.Time, to time.Time) {
+	from, err := time.Parse(time.RFC3339, config.From.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("from"), "Invalid time", err.Error())
+	}
+
+	to, err = time.Parse(time.RFC3339, config.To.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("to"), "Invalid time", err.Error())
+	}
+
+	return from, to
+}
+
+// getMetricsFunc requests the metrics of a single endpoint and returns them
+// as series.
+type getMetricsFunc func(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	config metricsDataSourceModel,
+	from time.Time,
+	to time.Time,
+) ([]utils.MetricsSeriesDataSourceModel, *http.Response, error)
+
+func getBandwidthMetrics(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	config metricsDataSourceModel,
+	from time.Time,
+	to time.Time,
+) ([]utils.MetricsSeriesDataSourceModel, *http.Response, error) {
+	request := api.GetServerBandwidthMetrics(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+	).From(from).To(to).Aggregation(config.Aggregation.ValueString())
+	if !config.Granularity.IsNull() {
+		request = request.Granularity(config.Granularity.ValueString())
+	}
+
+	result, response, err := request.Execute()
+	if err != nil {
+		return nil, response, err
+	}
+
+	metrics := result.GetMetrics()
+	return []utils.MetricsSeriesDataSourceModel{
+		adaptMetricToMetricsSeries("DOWN_PUBLIC", metrics.DownPublic),
+		adaptMetricToMetricsSeries("UP_PUBLIC", metrics.UpPublic),
+	}, response, nil
+}
+
+func getDatatrafficMetrics(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	config metricsDataSourceModel,
+	from time.Time,
+	to time.Time,
+) ([]utils.MetricsSeriesDataSourceModel, *http.Response, error) {
+	request := api.GetServerDataTrafficMetrics(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+	).From(from).To(to).Aggregation(config.Aggregation.ValueString())
+	if !config.Granularity.IsNull() {
+		request = request.Granularity(config.Granularity.ValueString())
+	}
+
+	result, response, err := request.Execute()
+	if err != nil {
+		return nil, response, err
+	}
+
+	metrics := result.GetMetrics()
+	return []utils.MetricsSeriesDataSourceModel{
+		adaptMetricToMetricsSeries("DOWN_PUBLIC", metrics.DownPublic),
+		adaptMetricToMetricsSeries("UP_PUBLIC", metrics.UpPublic),
+	}, response, nil
+}
+
+// metricsDataSource is shared by the bandwidth & datatraffic metrics, which
+// only differ in the endpoint that getMetrics requests.
+type metricsDataSource struct {
+	utils.DataSourceAPI
+	getMetrics getMetricsFunc
+}
+
+func (m *metricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the interval in RFC3339 format, e.g. `2025-01-28T00:00:00Z`",
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "End of the interval in RFC3339 format, e.g. `2025-01-29T00:00:00Z`",
+			},
+			"granularity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Valid options are " + utils.StringTypeArrayToMarkdown(metricsGranularities),
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricsGranularities...),
+				},
+			},
+			"aggregation": schema.StringAttribute{
+				Required:    true,
+				Description: "Valid options are " + utils.StringTypeArrayToMarkdown(metricsAggregations),
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricsAggregations...),
+				},
+			},
+			"series": utils.MetricsSeriesSchemaAttribute(
+				"One entry per series returned, i.e. *DOWN_PUBLIC* & *UP_PUBLIC*",
+			),
+		},
+	}
+}
+
+func (m *metricsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config metricsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, to := parseMetricsInterval(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	series, response, err := m.getMetrics(ctx, m.DedicatedserverAPI, config, from, to)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state := config
+	state.Series = series
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func NewBandwidthMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_bandwidth_metrics",
+		},
+		getMetrics: getBandwidthMetrics,
+	}
+}
+
+func NewDatatrafficMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_datatraffic_metrics",
+		},
+		getMetrics: getDatatrafficMetrics,
+	}
+}
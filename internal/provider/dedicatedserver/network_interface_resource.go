@@ -0,0 +1,380 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &networkInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &networkInterfaceResource{}
+	_ resource.ResourceWithImportState = &networkInterfaceResource{}
+)
+
+// networkInterfaceTypes maps the types used in the schema to the types
+// used in the API paths.
+var networkInterfaceTypes = map[string]dedicatedserver.NetworkTypeURL{
+	"public":            dedicatedserver.NETWORKTYPEURL_PUBLIC,
+	"internal":          dedicatedserver.NETWORKTYPEURL_INTERNAL,
+	"remote_management": dedicatedserver.NETWORKTYPEURL_REMOTE_MANAGEMENT,
+}
+
+// networkInterfaceTypeAll opens or closes all network interfaces at once.
+const networkInterfaceTypeAll = "all"
+
+const networkInterfaceStatusOpen = "open"
+
+func NewNetworkInterfaceResource() resource.Resource {
+	return &networkInterfaceResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_network_interface",
+		},
+	}
+}
+
+type networkInterfaceResource struct {
+	utils.ResourceAPI
+}
+
+type networkInterfaceResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Type              types.String `tfsdk:"type"`
+	Opened            types.Bool   `tfsdk:"opened"`
+	Status            types.String `tfsdk:"status"`
+	LinkSpeed         types.String `tfsdk:"link_speed"`
+}
+
+func (n *networkInterfaceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the network interface. `all` opens or closes all network interfaces of the server. Do not combine the types `public` & `all` with the `public_network_interface_opened` attribute of `leaseweb_dedicated_server`, as both manage the public network interface. Valid options are: \"public\", \"internal\", \"remote_management\", \"all\"",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"public",
+						"internal",
+						"remote_management",
+						networkInterfaceTypeAll,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"opened": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the network interface is opened or not.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the network interface.",
+			},
+			"link_speed": schema.StringAttribute{
+				Computed:    true,
+				Description: "The link speed of the network interface.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.DeleteAction},
+	)
+}
+
+// getNetworkInterfaceStatus returns the status & link speed of the network
+// interface. For networkInterfaceTypeAll the status is only open if all
+// network interfaces are open and the link speed is null.
+func getNetworkInterfaceStatus(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	interfaceType string,
+) (string, types.String, *http.Response, error) {
+	if interfaceType != networkInterfaceTypeAll {
+		result, response, err := api.GetNetworkInterface(
+			ctx,
+			serverID,
+			networkInterfaceTypes[interfaceType],
+		).Execute()
+		if err != nil {
+			return "", types.StringNull(), response, err
+		}
+
+		return result.GetStatus(),
+			types.StringValue(result.GetLinkSpeed()),
+			response,
+			nil
+	}
+
+	result, response, err := api.GetNetworkInterfaceList(ctx, serverID).Execute()
+	if err != nil {
+		return "", types.StringNull(), response, err
+	}
+
+	status := networkInterfaceStatusOpen
+	for _, networkInterface := range result.GetNetworkInterfaces() {
+		if networkInterface.GetStatus() != networkInterfaceStatusOpen {
+			status = networkInterface.GetStatus()
+			break
+		}
+	}
+
+	return status, types.StringNull(), response, nil
+}
+
+// getNetworkInterface returns an error until the status of the network
+// interface matches opened.
+func getNetworkInterface(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	interfaceType string,
+	opened bool,
+	diags *diag.Diagnostics,
+) (*networkInterfaceResourceModel, error) {
+	status, linkSpeed, response, err := getNetworkInterfaceStatus(
+		ctx,
+		api,
+		serverID,
+		interfaceType,
+	)
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return nil, backoff.Permanent(err)
+	}
+
+	if (status == networkInterfaceStatusOpen) != opened {
+		return nil, fmt.Errorf(
+			"network interface %s has status %s",
+			interfaceType,
+			status,
+		)
+	}
+
+	return &networkInterfaceResourceModel{
+		Status:    types.StringValue(status),
+		LinkSpeed: linkSpeed,
+	}, nil
+}
+
+// openOrCloseNetworkInterface uses the bulk operations for
+// networkInterfaceTypeAll.
+func (n *networkInterfaceResource) openOrCloseNetworkInterface(
+	ctx context.Context,
+	serverID string,
+	interfaceType string,
+	opened bool,
+) (*http.Response, error) {
+	networkType := networkInterfaceTypes[interfaceType]
+
+	switch {
+	case interfaceType == networkInterfaceTypeAll && opened:
+		return n.DedicatedserverAPI.OpenAllNetworkInterfaces(ctx, serverID).Execute()
+	case interfaceType == networkInterfaceTypeAll:
+		return n.DedicatedserverAPI.CloseAllNetworkInterfaces(ctx, serverID).Execute()
+	case opened:
+		return n.DedicatedserverAPI.OpenNetworkInterface(
+			ctx,
+			serverID,
+			networkType,
+		).Execute()
+	default:
+		return n.DedicatedserverAPI.CloseNetworkInterface(
+			ctx,
+			serverID,
+			networkType,
+		).Execute()
+	}
+}
+
+// updateNetworkInterface opens or closes the network interface & waits
+// until the operation has been executed.
+func (n *networkInterfaceResource) updateNetworkInterface(
+	ctx context.Context,
+	plan networkInterfaceResourceModel,
+	diags *diag.Diagnostics,
+) *networkInterfaceResourceModel {
+	serverID := plan.DedicatedServerID.ValueString()
+	interfaceType := plan.Type.ValueString()
+	opened := plan.Opened.ValueBool()
+
+	response, err := n.openOrCloseNetworkInterface(
+		ctx,
+		serverID,
+		interfaceType,
+		opened,
+	)
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return nil
+	}
+
+	pollNetworkInterface := func() (*networkInterfaceResourceModel, error) {
+		return getNetworkInterface(ctx, n.DedicatedserverAPI, serverID, interfaceType, opened, diags)
+	}
+
+	result, err := backoff.Retry(
+		ctx,
+		pollNetworkInterface,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil {
+		if !diags.HasError() {
+			utils.GeneralError(diags, ctx, err)
+		}
+		return nil
+	}
+
+	plan.Status = result.Status
+	plan.LinkSpeed = result.LinkSpeed
+
+	return &plan
+}
+
+func (n *networkInterfaceResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := n.updateNetworkInterface(ctx, plan, &resp.Diagnostics)
+	if state == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (n *networkInterfaceResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, linkSpeed, response, err := getNetworkInterfaceStatus(
+		ctx,
+		n.DedicatedserverAPI,
+		state.DedicatedServerID.ValueString(),
+		state.Type.ValueString(),
+	)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state.Opened = types.BoolValue(status == networkInterfaceStatusOpen)
+	state.Status = types.StringValue(status)
+	state.LinkSpeed = linkSpeed
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (n *networkInterfaceResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := n.updateNetworkInterface(ctx, plan, &resp.Diagnostics)
+	if state == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete leaves the network interface as is.
+func (n *networkInterfaceResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func (n *networkInterfaceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,type",
+			req.ID,
+		)
+		return
+	}
+
+	if _, ok := networkInterfaceTypes[idParts[1]]; !ok &&
+		idParts[1] != networkInterfaceTypeAll {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,type",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("type"),
+		idParts[1],
+	)...)
+}
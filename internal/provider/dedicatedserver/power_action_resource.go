@@ -0,0 +1,304 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource              = &powerActionResource{}
+	_ resource.ResourceWithConfigure = &powerActionResource{}
+)
+
+const (
+	powerActionReboot     = "reboot"
+	powerActionPowerCycle = "power_cycle"
+	powerActionIPMIReset  = "ipmi_reset"
+)
+
+// powerCycleOffTimeout is how long a power cycle is watched for the server
+// to power off. The server can be off for a shorter time than the polling
+// interval, so it is assumed to have been powered off once this has passed.
+const powerCycleOffTimeout = 2 * time.Minute
+
+// defaultPowerActionTimeout limits how long to wait for the action to
+// complete if no create timeout is configured.
+const defaultPowerActionTimeout = 15 * time.Minute
+
+func NewPowerActionResource() resource.Resource {
+	return &powerActionResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_power_action",
+		},
+	}
+}
+
+type powerActionResource struct {
+	utils.ResourceAPI
+}
+
+type powerActionResourceModel struct {
+	DedicatedServerID types.String   `tfsdk:"dedicated_server_id"`
+	Action            types.String   `tfsdk:"action"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (p *powerActionResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf(
+					"The action to execute. `%s` powers the server off and on again, `%s` power cycles the server through the PDU & `%s` resets the IPMI controller. Valid options are: %q, %q, %q",
+					powerActionReboot,
+					powerActionPowerCycle,
+					powerActionIPMIReset,
+					powerActionReboot,
+					powerActionPowerCycle,
+					powerActionIPMIReset,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						powerActionReboot,
+						powerActionPowerCycle,
+						powerActionIPMIReset,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will execute the action again.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the action to complete, e.g. `30m`. Defaults to `15m`.",
+			}),
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.UpdateAction, utils.DeleteAction},
+	)
+}
+
+// getPoweredOn returns an error until the power status of the server
+// matches poweredOn.
+func getPoweredOn(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	poweredOn bool,
+	diags *diag.Diagnostics,
+) (bool, error) {
+	result, response, err := api.GetPowerStatus(ctx, serverID).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return false, backoff.Permanent(err)
+	}
+
+	pdu := result.GetPdu()
+	ipmi := result.GetIpmi()
+	status := pdu.GetStatus() != "off" && ipmi.GetStatus() != "off"
+	if status != poweredOn {
+		return status, fmt.Errorf(
+			"expected powered on to be %t, got %t",
+			poweredOn,
+			status,
+		)
+	}
+
+	return status, nil
+}
+
+// waitForPowerStatus polls the power status until it matches poweredOn.
+func waitForPowerStatus(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	poweredOn bool,
+	diags *diag.Diagnostics,
+) {
+	pollPowerStatus := func() (bool, error) {
+		return getPoweredOn(ctx, api, serverID, poweredOn, diags)
+	}
+
+	_, err := backoff.Retry(
+		ctx,
+		pollPowerStatus,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+}
+
+// waitForPowerCycle polls the power status until the server has been
+// powered off & on again. A warning is added if the server was never seen
+// powered off.
+func waitForPowerCycle(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	diags *diag.Diagnostics,
+) {
+	pollPoweredOff := func() (bool, error) {
+		return getPoweredOn(ctx, api, serverID, false, diags)
+	}
+
+	// Not seeing the server powered off is not an error, see
+	// powerCycleOffTimeout.
+	_, err := backoff.Retry(
+		ctx,
+		pollPoweredOff,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+		backoff.WithMaxElapsedTime(powerCycleOffTimeout),
+	)
+	if diags.HasError() {
+		return
+	}
+	if err != nil && ctx.Err() == nil {
+		diags.AddWarning(
+			"Power off not observed",
+			fmt.Sprintf(
+				"Server %s was not seen powered off within %s, it is assumed to have been power cycled in between polls.",
+				serverID,
+				powerCycleOffTimeout,
+			),
+		)
+	}
+
+	waitForPowerStatus(ctx, api, serverID, true, diags)
+}
+
+func (p *powerActionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan powerActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultPowerActionTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serverID := plan.DedicatedServerID.ValueString()
+
+	switch plan.Action.ValueString() {
+	case powerActionReboot:
+		response, err := p.DedicatedserverAPI.PowerOff(ctx, serverID).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		waitForPowerStatus(ctx, p.DedicatedserverAPI, serverID, false, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		response, err = p.DedicatedserverAPI.PowerOn(ctx, serverID).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		waitForPowerStatus(ctx, p.DedicatedserverAPI, serverID, true, &resp.Diagnostics)
+	case powerActionPowerCycle:
+		response, err := p.DedicatedserverAPI.PowerCycleServer(ctx, serverID).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		waitForPowerCycle(ctx, p.DedicatedserverAPI, serverID, &resp.Diagnostics)
+	case powerActionIPMIReset:
+		result, response, err := p.DedicatedserverAPI.IpmiReset(ctx, serverID).
+			IpmiResetOpts(*dedicatedserver.NewIpmiResetOpts()).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+		waitForJob(
+			ctx,
+			p.DedicatedserverAPI,
+			serverID,
+			result.GetUuid(),
+			"IPMI reset",
+			&resp.Diagnostics,
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read keeps the state as is, as an executed action cannot be looked up.
+func (p *powerActionResource) Read(
+	_ context.Context,
+	_ resource.ReadRequest,
+	_ *resource.ReadResponse,
+) {
+}
+
+func (p *powerActionResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+// Delete only removes the resource from the state as an executed action
+// cannot be undone.
+func (p *powerActionResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
@@ -0,0 +1,413 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &privateNetworkResource{}
+	_ resource.ResourceWithConfigure   = &privateNetworkResource{}
+	_ resource.ResourceWithImportState = &privateNetworkResource{}
+)
+
+const privateNetworkStatusConfigured = "CONFIGURED"
+
+func NewPrivateNetworkResource() resource.Resource {
+	return &privateNetworkResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_private_network",
+		},
+	}
+}
+
+type privateNetworkResource struct {
+	utils.ResourceAPI
+}
+
+type privateNetworkResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	PrivateNetworkID  types.String `tfsdk:"private_network_id"`
+	LinkSpeed         types.Int32  `tfsdk:"link_speed"`
+	Status            types.String `tfsdk:"status"`
+	Subnet            types.String `tfsdk:"subnet"`
+	VLANID            types.String `tfsdk:"vlan_id"`
+	PrivateIP         types.String `tfsdk:"private_ip"`
+}
+
+// findPrivateNetwork returns nil if the server is not part of the private
+// network.
+func findPrivateNetwork(
+	server dedicatedserver.Server,
+	privateNetworkID string,
+) *dedicatedserver.PrivateNetwork {
+	for _, privateNetwork := range server.GetPrivateNetworks() {
+		if privateNetwork.GetId() == privateNetworkID {
+			return &privateNetwork
+		}
+	}
+
+	return nil
+}
+
+func adaptServerToPrivateNetworkResource(
+	server dedicatedserver.Server,
+	privateNetwork dedicatedserver.PrivateNetwork,
+	dedicatedServerID types.String,
+) privateNetworkResourceModel {
+	networkInterfaces := server.GetNetworkInterfaces()
+	internal := networkInterfaces.GetInternal()
+
+	return privateNetworkResourceModel{
+		DedicatedServerID: dedicatedServerID,
+		PrivateNetworkID:  types.StringValue(privateNetwork.GetId()),
+		LinkSpeed:         types.Int32Value(privateNetwork.GetLinkSpeed()),
+		Status:            types.StringValue(privateNetwork.GetStatus()),
+		Subnet:            types.StringValue(privateNetwork.GetSubnet()),
+		VLANID:            types.StringValue(privateNetwork.GetVlanId()),
+		PrivateIP:         types.StringValue(internal.GetIp()),
+	}
+}
+
+func (p *privateNetworkResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"link_speed": schema.Int32Attribute{
+				Required:    true,
+				Description: "The port speed in Mbps. Valid options are: 100, 1000, 10000",
+				Validators: []validator.Int32{
+					int32validator.OneOf(100, 1000, 10000),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The configuration status of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subnet of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vlan_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VLAN ID of the private network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The private IP assigned to the internal network interface of the dedicated server.",
+			},
+		},
+	}
+}
+
+// getPrivateNetwork returns an error until the private network of the
+// server is configured with linkSpeed. If linkSpeed is nil, an error is
+// returned until the server has been removed from the private network.
+func getPrivateNetwork(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	privateNetworkID string,
+	linkSpeed *int32,
+	diags *diag.Diagnostics,
+) (*dedicatedserver.Server, error) {
+	server, response, err := api.GetServer(ctx, serverID).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return nil, backoff.Permanent(err)
+	}
+
+	privateNetwork := findPrivateNetwork(*server, privateNetworkID)
+
+	if linkSpeed == nil {
+		if privateNetwork != nil {
+			return nil, fmt.Errorf(
+				"private network %s has status %s",
+				privateNetworkID,
+				privateNetwork.GetStatus(),
+			)
+		}
+
+		return server, nil
+	}
+
+	if privateNetwork == nil ||
+		privateNetwork.GetStatus() != privateNetworkStatusConfigured ||
+		privateNetwork.GetLinkSpeed() != *linkSpeed {
+		return nil, fmt.Errorf(
+			"private network %s has not been configured yet",
+			privateNetworkID,
+		)
+	}
+
+	return server, nil
+}
+
+// waitForPrivateNetwork polls the server until the private network has
+// been configured with linkSpeed, or removed if linkSpeed is nil.
+func waitForPrivateNetwork(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+	privateNetworkID string,
+	linkSpeed *int32,
+	diags *diag.Diagnostics,
+) *dedicatedserver.Server {
+	pollPrivateNetwork := func() (*dedicatedserver.Server, error) {
+		return getPrivateNetwork(
+			ctx,
+			api,
+			serverID,
+			privateNetworkID,
+			linkSpeed,
+			diags,
+		)
+	}
+
+	server, err := backoff.Retry(
+		ctx,
+		pollPrivateNetwork,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+
+	return server
+}
+
+// addToPrivateNetwork is used both to add the server to the private network
+// and to change the link speed. The state is saved before waiting for the
+// private network to be configured, so a failed wait does not lose track
+// of the server.
+func (p *privateNetworkResource) addToPrivateNetwork(
+	ctx context.Context,
+	plan privateNetworkResourceModel,
+	state *tfsdk.State,
+	diags *diag.Diagnostics,
+) {
+	serverID := plan.DedicatedServerID.ValueString()
+	privateNetworkID := plan.PrivateNetworkID.ValueString()
+	linkSpeed := plan.LinkSpeed.ValueInt32()
+
+	opts := dedicatedserver.NewAddServerToPrivateNetworkOpts(linkSpeed)
+	response, err := p.DedicatedserverAPI.AddServerToPrivateNetwork(
+		ctx,
+		serverID,
+		privateNetworkID,
+	).AddServerToPrivateNetworkOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return
+	}
+
+	// The computed values are only known once the private network has been
+	// configured.
+	partialState := plan
+	for _, value := range []*types.String{
+		&partialState.Status,
+		&partialState.Subnet,
+		&partialState.VLANID,
+		&partialState.PrivateIP,
+	} {
+		if value.IsUnknown() {
+			*value = types.StringNull()
+		}
+	}
+	diags.Append(state.Set(ctx, partialState)...)
+	if diags.HasError() {
+		return
+	}
+
+	server := waitForPrivateNetwork(
+		ctx,
+		p.DedicatedserverAPI,
+		serverID,
+		privateNetworkID,
+		&linkSpeed,
+		diags,
+	)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(
+		state.Set(
+			ctx,
+			adaptServerToPrivateNetworkResource(
+				*server,
+				*findPrivateNetwork(*server, privateNetworkID),
+				plan.DedicatedServerID,
+			),
+		)...,
+	)
+}
+
+func (p *privateNetworkResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan privateNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p.addToPrivateNetwork(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+func (p *privateNetworkResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, response, err := p.DedicatedserverAPI.GetServer(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	privateNetwork := findPrivateNetwork(*server, state.PrivateNetworkID.ValueString())
+	if privateNetwork == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			adaptServerToPrivateNetworkResource(
+				*server,
+				*privateNetwork,
+				state.DedicatedServerID,
+			),
+		)...,
+	)
+}
+
+func (p *privateNetworkResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan privateNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p.addToPrivateNetwork(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+func (p *privateNetworkResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := state.DedicatedServerID.ValueString()
+	privateNetworkID := state.PrivateNetworkID.ValueString()
+
+	response, err := p.DedicatedserverAPI.RemoveServerFromPrivateNetwork(
+		ctx,
+		serverID,
+		privateNetworkID,
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	waitForPrivateNetwork(
+		ctx,
+		p.DedicatedserverAPI,
+		serverID,
+		privateNetworkID,
+		nil,
+		&resp.Diagnostics,
+	)
+}
+
+func (p *privateNetworkResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		utils.UnexpectedImportIdentifierError(
+			&resp.Diagnostics,
+			"dedicated_server_id,private_network_id",
+			req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("dedicated_server_id"),
+		idParts[0],
+	)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx,
+		path.Root("private_network_id"),
+		idParts[1],
+	)...)
+}
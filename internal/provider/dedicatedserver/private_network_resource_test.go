@@ -0,0 +1,66 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPrivateNetwork(id string, status string) dedicatedserver.PrivateNetwork {
+	privateNetwork := dedicatedserver.PrivateNetwork{}
+	privateNetwork.SetId(id)
+	privateNetwork.SetLinkSpeed(1000)
+	privateNetwork.SetStatus(status)
+	privateNetwork.SetSubnet("127.0.0.80/29")
+	privateNetwork.SetVlanId("2120")
+
+	return privateNetwork
+}
+
+func Test_findPrivateNetwork(t *testing.T) {
+	server := dedicatedserver.Server{}
+	server.SetPrivateNetworks([]dedicatedserver.PrivateNetwork{
+		newPrivateNetwork("1", privateNetworkStatusConfigured),
+		newPrivateNetwork("2", "CONFIGURING"),
+	})
+
+	t.Run("private network is found", func(t *testing.T) {
+		got := findPrivateNetwork(server, "2")
+
+		assert.NotNil(t, got)
+		assert.Equal(t, "2", got.GetId())
+		assert.Equal(t, "CONFIGURING", got.GetStatus())
+	})
+
+	t.Run("nil is returned if the server is not part of the private network", func(t *testing.T) {
+		got := findPrivateNetwork(server, "3")
+
+		assert.Nil(t, got)
+	})
+}
+
+func Test_adaptServerToPrivateNetworkResource(t *testing.T) {
+	privateNetwork := newPrivateNetwork("1", privateNetworkStatusConfigured)
+	server := dedicatedserver.Server{}
+	server.SetPrivateNetworks([]dedicatedserver.PrivateNetwork{privateNetwork})
+
+	got := adaptServerToPrivateNetworkResource(
+		server,
+		privateNetwork,
+		basetypes.NewStringValue("12345"),
+	)
+
+	want := privateNetworkResourceModel{
+		DedicatedServerID: basetypes.NewStringValue("12345"),
+		PrivateNetworkID:  basetypes.NewStringValue("1"),
+		LinkSpeed:         basetypes.NewInt32Value(1000),
+		Status:            basetypes.NewStringValue(privateNetworkStatusConfigured),
+		Subnet:            basetypes.NewStringValue("127.0.0.80/29"),
+		VLANID:            basetypes.NewStringValue("2120"),
+		PrivateIP:         basetypes.NewStringValue(""),
+	}
+
+	assert.Equal(t, want, got)
+}
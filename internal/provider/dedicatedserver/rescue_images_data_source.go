@@ -0,0 +1,109 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &rescueImagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rescueImagesDataSource{}
+)
+
+type rescueImagesDataSource struct {
+	utils.DataSourceAPI
+}
+
+type rescueImageDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type rescueImagesDataSourceModel struct {
+	RescueImages []rescueImageDataSourceModel `tfsdk:"rescue_images"`
+}
+
+func (r *rescueImagesDataSource) Read(
+	ctx context.Context,
+	_ datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var rescueImages []rescueImageDataSourceModel
+	var offset *int32
+
+	request := r.DedicatedserverAPI.GetRescueImageList(ctx)
+	for {
+		result, response, err := request.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+
+		for _, rescueImage := range result.GetRescueImages() {
+			rescueImages = append(rescueImages, rescueImageDataSourceModel{
+				ID:   basetypes.NewStringValue(rescueImage.GetId()),
+				Name: basetypes.NewStringValue(rescueImage.GetName()),
+			})
+		}
+
+		metadata := result.GetMetadata()
+
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			break
+		}
+
+		request = request.Offset(*offset)
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(
+			ctx,
+			rescueImagesDataSourceModel{RescueImages: rescueImages},
+		)...,
+	)
+}
+
+func (r *rescueImagesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rescue_images": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the rescue image.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the rescue image.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewRescueImagesDataSource() datasource.DataSource {
+	return &rescueImagesDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_rescue_images",
+		},
+	}
+}
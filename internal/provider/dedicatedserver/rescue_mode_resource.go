@@ -0,0 +1,225 @@
+package dedicatedserver
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource              = &rescueModeResource{}
+	_ resource.ResourceWithConfigure = &rescueModeResource{}
+)
+
+func NewRescueModeResource() resource.Resource {
+	return &rescueModeResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_rescue_mode",
+		},
+	}
+}
+
+type rescueModeResource struct {
+	utils.ResourceAPI
+}
+
+type rescueModeResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	DedicatedServerID types.String   `tfsdk:"dedicated_server_id"`
+	RescueImageID     types.String   `tfsdk:"rescue_image_id"`
+	SSHKeys           []types.String `tfsdk:"ssh_keys"`
+	Password          types.String   `tfsdk:"password"`
+	PostInstallScript types.String   `tfsdk:"post_install_script"`
+	CallbackURL       types.String   `tfsdk:"callback_url"`
+	PowerCycle        types.Bool     `tfsdk:"power_cycle"`
+}
+
+func (r *rescueModeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the rescue mode job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rescue_image_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Rescue image identifier. The available rescue images can be found with the `leaseweb_dedicated_server_rescue_images` data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_keys": schema.SetAttribute{
+				Description: "List of public sshKeys to be setup in the rescue environment",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Rescue mode password. If not provided, it would be automatically generated",
+				Optional:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"post_install_script": schema.StringAttribute{
+				Description: "A valid bash script to run right after the server has booted into rescue mode.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Description: "Url which will receive callbacks when the rescue mode is launched or failed",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_cycle": schema.BoolAttribute{
+				Description: "If true, the server is rebooted automatically into rescue mode and Terraform waits until rescue mode has been launched. Otherwise, you should reboot it manually and Terraform does not wait for the rescue mode job to finish. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.UpdateAction, utils.DeleteAction},
+	)
+}
+
+func (r *rescueModeResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan rescueModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var SSHKeysList []string
+	for _, k := range plan.SSHKeys {
+		if utils.AdaptStringPointerValueToNullableString(k) != nil {
+			SSHKeysList = append(SSHKeysList, k.ValueString())
+		}
+	}
+	SSHKeys := strings.Join(SSHKeysList, "\n")
+
+	opts := dedicatedserver.NewEnableServerRescueModeOpts(plan.RescueImageID.ValueString())
+	opts.CallbackUrl = utils.AdaptStringPointerValueToNullableString(plan.CallbackURL)
+	opts.Password = utils.AdaptStringPointerValueToNullableString(plan.Password)
+	opts.PowerCycle = utils.AdaptBoolPointerValueToNullableBool(plan.PowerCycle)
+	if !plan.PostInstallScript.IsNull() {
+		opts.PostInstallScript = utils.AdaptStringValueToNullableString(base64.StdEncoding.EncodeToString([]byte(strings.TrimSpace(plan.PostInstallScript.ValueString()))))
+	}
+	if len(SSHKeysList) > 0 {
+		opts.SshKeys = &SSHKeys
+	}
+
+	serverID := plan.DedicatedServerID.ValueString()
+	result, response, err := r.DedicatedserverAPI.EnableServerRescueMode(ctx, serverID).
+		EnableServerRescueModeOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	plan.ID = types.StringValue(result.GetUuid())
+
+	// Without a power cycle the job only finishes once the server has been
+	// rebooted manually, so there is nothing to wait for.
+	if plan.PowerCycle.ValueBool() {
+		waitForJob(
+			ctx,
+			r.DedicatedserverAPI,
+			serverID,
+			result.GetUuid(),
+			"Rescue mode",
+			&resp.Diagnostics,
+		)
+	}
+
+	// The state is also set if the job failed, so the resource is tainted
+	// and the next apply launches rescue mode again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rescueModeResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state rescueModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, response, err := r.DedicatedserverAPI.GetJob(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *rescueModeResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+// Delete only removes the resource from the state. The server leaves rescue
+// mode the next time it is rebooted.
+func (r *rescueModeResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
@@ -22,6 +23,7 @@ type serverDataSourceModel struct {
 	ID                                 types.String `tfsdk:"id"`
 	AssetID                            types.String `tfsdk:"asset_id"`
 	ContractID                         types.String `tfsdk:"contract_id"`
+	ContractStatus                     types.String `tfsdk:"contract_status"`
 	CPUQuantity                        types.Int32  `tfsdk:"cpu_quantity"`
 	CPUType                            types.String `tfsdk:"cpu_type"`
 	InternalGateway                    types.String `tfsdk:"internal_gateway"`
@@ -50,24 +52,17 @@ type serverDataSourceModel struct {
 	SerialNumber                       types.String `tfsdk:"serial_number"`
 }
 
-func (s *serverDataSource) Read(
-	ctx context.Context,
-	req datasource.ReadRequest,
-	resp *datasource.ReadResponse,
-) {
-	var config serverDataSourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
-
-	request := s.DedicatedserverAPI.GetServer(ctx, config.ID.ValueString())
-	result, response, err := request.Execute()
-	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, response)
-		return
-	}
-
-	var contractID *string
+func adaptServerToServerDataSource(
+	result dedicatedserver.Server,
+) serverDataSourceModel {
+	var contractID, contractStatus *string
 	if contract, ok := result.GetContractOk(); ok {
 		contractID, _ = contract.GetIdOk()
+
+		if cs, ok := contract.GetStatusOk(); ok && cs != nil {
+			csStr := string(*cs)
+			contractStatus = &csStr
+		}
 	}
 
 	var rackID, rackCapacity, rackType *string
@@ -143,167 +138,199 @@ func (s *serverDataSource) Read(
 		}
 	}
 
+	return serverDataSourceModel{
+		ID:                                 types.StringValue(result.GetId()),
+		AssetID:                            types.StringValue(result.GetAssetId()),
+		ContractID:                         types.StringPointerValue(contractID),
+		ContractStatus:                     types.StringPointerValue(contractStatus),
+		CPUQuantity:                        types.Int32PointerValue(cpuQuantity),
+		CPUType:                            types.StringPointerValue(cpuType),
+		InternalGateway:                    types.StringPointerValue(internalGateway),
+		InternalIP:                         types.StringPointerValue(internalIP),
+		InternalMAC:                        types.StringPointerValue(internalMAC),
+		SerialNumber:                       types.StringValue(result.GetSerialNumber()),
+		IsAutomationFeatureAvailable:       types.BoolPointerValue(automation),
+		IsIPMIRebootFeatureAvailable:       types.BoolPointerValue(ipmiReboot),
+		IsPowerCycleFeatureAvailable:       types.BoolPointerValue(powerCycle),
+		IsPrivateNetworkFeatureAvailable:   types.BoolPointerValue(privateNetwork),
+		IsRemoteManagementFeatureAvailable: types.BoolPointerValue(remoteManagement),
+		LocationRack:                       types.StringPointerValue(locationRack),
+		LocationSite:                       types.StringPointerValue(locationSite),
+		LocationSuite:                      types.StringPointerValue(locationSuite),
+		LocationUnit:                       types.StringPointerValue(locationUnit),
+		PublicGateway:                      types.StringPointerValue(publicGateway),
+		PublicIP:                           types.StringPointerValue(publicIP),
+		PublicMAC:                          types.StringPointerValue(publicMAC),
+		RackCapacity:                       types.StringPointerValue(rackCapacity),
+		RackID:                             types.StringPointerValue(rackID),
+		RackType:                           types.StringPointerValue(rackType),
+		RAMSize:                            types.Int32PointerValue(ramSize),
+		RAMUnit:                            types.StringPointerValue(ramUnit),
+		RemoteGateway:                      types.StringPointerValue(remoteGateway),
+		RemoteIP:                           types.StringPointerValue(remoteIP),
+		RemoteMAC:                          types.StringPointerValue(remoteMAC),
+	}
+}
+
+func (s *serverDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config serverDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	request := s.DedicatedserverAPI.GetServer(ctx, config.ID.ValueString())
+	result, response, err := request.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
 	resp.Diagnostics.Append(
-		resp.State.Set(
-			ctx,
-			serverDataSourceModel{
-				ID:                                 types.StringValue(result.GetId()),
-				AssetID:                            types.StringValue(result.GetAssetId()),
-				ContractID:                         types.StringPointerValue(contractID),
-				CPUQuantity:                        types.Int32PointerValue(cpuQuantity),
-				CPUType:                            types.StringPointerValue(cpuType),
-				InternalGateway:                    types.StringPointerValue(internalGateway),
-				InternalIP:                         types.StringPointerValue(internalIP),
-				InternalMAC:                        types.StringPointerValue(internalMAC),
-				SerialNumber:                       types.StringValue(result.GetSerialNumber()),
-				IsAutomationFeatureAvailable:       types.BoolPointerValue(automation),
-				IsIPMIRebootFeatureAvailable:       types.BoolPointerValue(ipmiReboot),
-				IsPowerCycleFeatureAvailable:       types.BoolPointerValue(powerCycle),
-				IsPrivateNetworkFeatureAvailable:   types.BoolPointerValue(privateNetwork),
-				IsRemoteManagementFeatureAvailable: types.BoolPointerValue(remoteManagement),
-				LocationRack:                       types.StringPointerValue(locationRack),
-				LocationSite:                       types.StringPointerValue(locationSite),
-				LocationSuite:                      types.StringPointerValue(locationSuite),
-				LocationUnit:                       types.StringPointerValue(locationUnit),
-				PublicGateway:                      types.StringPointerValue(publicGateway),
-				PublicIP:                           types.StringPointerValue(publicIP),
-				PublicMAC:                          types.StringPointerValue(publicMAC),
-				RackCapacity:                       types.StringPointerValue(rackCapacity),
-				RackID:                             types.StringPointerValue(rackID),
-				RackType:                           types.StringPointerValue(rackType),
-				RAMSize:                            types.Int32PointerValue(ramSize),
-				RAMUnit:                            types.StringPointerValue(ramUnit),
-				RemoteGateway:                      types.StringPointerValue(remoteGateway),
-				RemoteIP:                           types.StringPointerValue(remoteIP),
-				RemoteMAC:                          types.StringPointerValue(remoteMAC),
-			},
-		)...,
+		resp.State.Set(ctx, adaptServerToServerDataSource(*result))...,
 	)
 }
 
+// serverSchemaAttributes returns the attributes that are shared by the
+// server data source & the detail mode of the servers data source.
+func serverSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the server.",
+		},
+		"asset_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The Asset ID of the server.",
+		},
+		"serial_number": schema.StringAttribute{
+			Computed:    true,
+			Description: "Serial number of server.",
+		},
+		"contract_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the contract.",
+		},
+		"contract_status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the contract.",
+		},
+		"rack_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the rack.",
+		},
+		"rack_capacity": schema.StringAttribute{
+			Computed:    true,
+			Description: "The capacity of the rack.",
+		},
+		"rack_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the rack.",
+		},
+		"is_automation_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if automation feature is available for the server.",
+		},
+		"is_ipmi_reboot_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if ipmi_reboot feature is available for the server.",
+		},
+		"is_power_cycle_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if power_cycle feature is available for the server.",
+		},
+		"is_private_network_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if private network feature is available for the server.",
+		},
+		"is_remote_management_feature_available": schema.BoolAttribute{
+			Computed:    true,
+			Description: "To check if remote management feature is available for the server.",
+		},
+		"location_rack": schema.StringAttribute{
+			Computed: true,
+		},
+		"location_site": schema.StringAttribute{
+			Computed:    true,
+			Description: "The site of the location.",
+		},
+		"location_suite": schema.StringAttribute{
+			Computed:    true,
+			Description: "The suite of the location.",
+		},
+		"location_unit": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unit of the location.",
+		},
+		"public_mac": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public mac address.",
+		},
+		"public_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public ip address.",
+		},
+		"public_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "Public gateway.",
+		},
+		"internal_mac": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal mac address.",
+		},
+		"internal_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal ip address.",
+		},
+		"internal_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal gateway.",
+		},
+		"remote_mac": schema.StringAttribute{
+			Computed:    true,
+			Description: "Remote mac address.",
+		},
+		"remote_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "Remote ip address.",
+		},
+		"remote_gateway": schema.StringAttribute{
+			Computed:    true,
+			Description: "Remote gateway.",
+		},
+		"ram_size": schema.Int32Attribute{
+			Computed:    true,
+			Description: "The size of the ram.",
+		},
+		"ram_unit": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unit of the ram.",
+		},
+		"cpu_quantity": schema.Int32Attribute{
+			Computed:    true,
+			Description: "The quantity of the cpu.",
+		},
+		"cpu_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the cpu.",
+		},
+	}
+}
+
 func (s *serverDataSource) Schema(
 	_ context.Context,
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
+	attributes := serverSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the server.",
+	}
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The unique identifier of the server.",
-			},
-			"asset_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The Asset ID of the server.",
-			},
-			"serial_number": schema.StringAttribute{
-				Computed:    true,
-				Description: "Serial number of server.",
-			},
-			"contract_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unique identifier of the contract.",
-			},
-			"rack_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The ID of the rack.",
-			},
-			"rack_capacity": schema.StringAttribute{
-				Computed:    true,
-				Description: "The capacity of the rack.",
-			},
-			"rack_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "The type of the rack.",
-			},
-			"is_automation_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if automation feature is available for the server.",
-			},
-			"is_ipmi_reboot_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if ipmi_reboot feature is available for the server.",
-			},
-			"is_power_cycle_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if power_cycle feature is available for the server.",
-			},
-			"is_private_network_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if private network feature is available for the server.",
-			},
-			"is_remote_management_feature_available": schema.BoolAttribute{
-				Computed:    true,
-				Description: "To check if remote management feature is available for the server.",
-			},
-			"location_rack": schema.StringAttribute{
-				Computed: true,
-			},
-			"location_site": schema.StringAttribute{
-				Computed:    true,
-				Description: "The site of the location.",
-			},
-			"location_suite": schema.StringAttribute{
-				Computed:    true,
-				Description: "The suite of the location.",
-			},
-			"location_unit": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unit of the location.",
-			},
-			"public_mac": schema.StringAttribute{
-				Computed:    true,
-				Description: "Public mac address.",
-			},
-			"public_ip": schema.StringAttribute{
-				Computed:    true,
-				Description: "Public ip address.",
-			},
-			"public_gateway": schema.StringAttribute{
-				Computed:    true,
-				Description: "Public gateway.",
-			},
-			"internal_mac": schema.StringAttribute{
-				Computed:    true,
-				Description: "Internal mac address.",
-			},
-			"internal_ip": schema.StringAttribute{
-				Computed:    true,
-				Description: "Internal ip address.",
-			},
-			"internal_gateway": schema.StringAttribute{
-				Computed:    true,
-				Description: "Internal gateway.",
-			},
-			"remote_mac": schema.StringAttribute{
-				Computed:    true,
-				Description: "Remote mac address.",
-			},
-			"remote_ip": schema.StringAttribute{
-				Computed:    true,
-				Description: "Remote ip address.",
-			},
-			"remote_gateway": schema.StringAttribute{
-				Computed:    true,
-				Description: "Remote gateway.",
-			},
-			"ram_size": schema.Int32Attribute{
-				Computed:    true,
-				Description: "The size of the ram.",
-			},
-			"ram_unit": schema.StringAttribute{
-				Computed:    true,
-				Description: "The unit of the ram.",
-			},
-			"cpu_quantity": schema.Int32Attribute{
-				Computed:    true,
-				Description: "The quantity of the cpu.",
-			},
-			"cpu_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "The type of the cpu.",
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
@@ -89,7 +89,7 @@ func (s *serverResource) Schema(
 			"dhcp_lease": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "The URL of PXE boot the dedicated server is booting from.",
+				Description: "The URL of PXE boot the dedicated server is booting from. Do not combine with `leaseweb_dedicated_server_dhcp_reservation`.",
 			},
 			"powered_on": schema.BoolAttribute{
 				Optional:    true,
@@ -99,7 +99,7 @@ func (s *serverResource) Schema(
 			"public_network_interface_opened": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Whether the public network interface of the dedicated server is opened or not.",
+				Description: "Whether the public network interface of the dedicated server is opened or not. Do not combine with `leaseweb_dedicated_server_network_interface`.",
 			},
 			"public_ip_null_routed": schema.BoolAttribute{
 				Optional:    true,
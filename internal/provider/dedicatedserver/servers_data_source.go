@@ -2,10 +2,15 @@ package dedicatedserver
 
 import (
 	"context"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
@@ -19,14 +24,88 @@ type serversDataSource struct {
 }
 
 type serversDataSourceModel struct {
-	IDs                   []types.String `tfsdk:"ids"`
-	Reference             types.String   `tfsdk:"reference"`
-	IP                    types.String   `tfsdk:"ip"`
-	MacAddress            types.String   `tfsdk:"mac_address"`
-	Site                  types.String   `tfsdk:"site"`
-	PrivateRackID         types.String   `tfsdk:"private_rack_id"`
-	PrivateNetworkCapable types.String   `tfsdk:"private_network_capable"`
-	PrivateNetworkEnabled types.String   `tfsdk:"private_network_enabled"`
+	IDs                   []types.String          `tfsdk:"ids"`
+	Reference             types.String            `tfsdk:"reference"`
+	IP                    types.String            `tfsdk:"ip"`
+	MacAddress            types.String            `tfsdk:"mac_address"`
+	Site                  types.String            `tfsdk:"site"`
+	PrivateRackID         types.String            `tfsdk:"private_rack_id"`
+	PrivateNetworkCapable types.String            `tfsdk:"private_network_capable"`
+	PrivateNetworkEnabled types.String            `tfsdk:"private_network_enabled"`
+	CPUType               types.String            `tfsdk:"cpu_type"`
+	MinRAM                types.Int32             `tfsdk:"min_ram"`
+	RackType              types.String            `tfsdk:"rack_type"`
+	ContractStatus        types.String            `tfsdk:"contract_status"`
+	Details               types.Bool              `tfsdk:"details"`
+	Servers               []serverDataSourceModel `tfsdk:"servers"`
+}
+
+// serverFilter contains the filters that are not supported by the API.
+type serverFilter struct {
+	cpuType        types.String
+	minRAM         types.Int32
+	rackType       types.String
+	contractStatus types.String
+}
+
+// isSet returns true if any of the filters is set.
+func (f serverFilter) isSet() bool {
+	return !f.cpuType.IsNull() ||
+		!f.minRAM.IsNull() ||
+		!f.rackType.IsNull() ||
+		!f.contractStatus.IsNull()
+}
+
+// ramSizeInGB converts the ram size of the server specs to GB. Sizes below
+// 1 GB are rounded down. Unknown units are assumed to be GB.
+func ramSizeInGB(size int32, unit string) int32 {
+	switch strings.ToUpper(unit) {
+	case "KB":
+		return size / (1024 * 1024)
+	case "MB":
+		return size / 1024
+	case "TB":
+		return size * 1024
+	default:
+		return size
+	}
+}
+
+func (f serverFilter) matches(server dedicatedserver.Server) bool {
+	specs := server.GetSpecs()
+
+	if !f.cpuType.IsNull() {
+		cpu := specs.GetCpu()
+		if !strings.Contains(
+			strings.ToLower(cpu.GetType()),
+			strings.ToLower(f.cpuType.ValueString()),
+		) {
+			return false
+		}
+	}
+
+	if !f.minRAM.IsNull() {
+		ram := specs.GetRam()
+		if ramSizeInGB(ram.GetSize(), ram.GetUnit()) < f.minRAM.ValueInt32() {
+			return false
+		}
+	}
+
+	if !f.rackType.IsNull() {
+		rack := server.GetRack()
+		if string(rack.GetType()) != f.rackType.ValueString() {
+			return false
+		}
+	}
+
+	if !f.contractStatus.IsNull() {
+		contract := server.GetContract()
+		if string(contract.GetStatus()) != f.contractStatus.ValueString() {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (s *serversDataSource) Read(
@@ -36,7 +115,8 @@ func (s *serversDataSource) Read(
 ) {
 	var config serversDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
-	// NOTE: we show only the latest 50 items.
+	// NOTE: without filters that are not supported by the API & without
+	// details we show only the latest 50 items.
 	request := s.DedicatedserverAPI.GetServerList(ctx).Limit(50)
 
 	if !config.Reference.IsNull() && !config.Reference.IsUnknown() {
@@ -67,15 +147,54 @@ func (s *serversDataSource) Read(
 		request = request.PrivateNetworkEnabled(config.PrivateNetworkEnabled.ValueString())
 	}
 
+	filter := serverFilter{
+		cpuType:        config.CPUType,
+		minRAM:         config.MinRAM,
+		rackType:       config.RackType,
+		contractStatus: config.ContractStatus,
+	}
+
+	// Filters that are not supported by the API are applied to the listed
+	// servers, so all pages are needed to find every matching server.
+	fetchAllPages := filter.isSet() || config.Details.ValueBool()
+
 	var Ids []types.String
+	var servers []serverDataSourceModel
 
-	result, response, err := request.Execute()
-	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, response)
-		return
-	}
-	for _, server := range result.GetServers() {
-		Ids = append(Ids, types.StringValue(server.GetId()))
+	for {
+		result, response, err := request.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+
+		// The listed servers contain the same details as a single server,
+		// so they do not need to be fetched one by one.
+		for _, server := range result.GetServers() {
+			if !filter.matches(server) {
+				continue
+			}
+			Ids = append(Ids, types.StringValue(server.GetId()))
+			if config.Details.ValueBool() {
+				servers = append(servers, adaptServerToServerDataSource(server))
+			}
+		}
+
+		if !fetchAllPages {
+			break
+		}
+
+		metadata := result.GetMetadata()
+		offset := utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			break
+		}
+
+		request = request.Offset(*offset)
 	}
 
 	resp.Diagnostics.Append(
@@ -90,6 +209,12 @@ func (s *serversDataSource) Read(
 				PrivateRackID:         config.PrivateRackID,
 				PrivateNetworkCapable: config.PrivateNetworkCapable,
 				PrivateNetworkEnabled: config.PrivateNetworkEnabled,
+				CPUType:               config.CPUType,
+				MinRAM:                config.MinRAM,
+				RackType:              config.RackType,
+				ContractStatus:        config.ContractStatus,
+				Details:               config.Details,
+				Servers:               servers,
 			},
 		)...,
 	)
@@ -135,6 +260,42 @@ func (s *serversDataSource) Schema(
 				Optional:    true,
 				Description: "Filter the list for private network enabled servers.",
 			},
+			"cpu_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of servers by cpu type. Servers match if their cpu type contains the value, ignoring case.",
+			},
+			"min_ram": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Filter the list for servers with at least this amount of ram in GB.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"rack_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of servers by rack type, e.g. `SHARED` or `DEDICATED`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedRackTypeEnumValues)...),
+				},
+			},
+			"contract_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of servers by contract status, e.g. `ACTIVE`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedContractStatusEnumValues)...),
+				},
+			},
+			"details": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, `servers` contains the details of every server in `ids`.",
+			},
+			"servers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The details of the servers. Only set if `details` is true.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverSchemaAttributes(),
+				},
+			},
 		},
 	}
 }
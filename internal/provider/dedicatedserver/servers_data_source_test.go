@@ -0,0 +1,151 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFilterTestServer() dedicatedserver.Server {
+	server := dedicatedserver.Server{}
+	server.SetId("12345")
+
+	specs := server.GetSpecs()
+	cpu := specs.GetCpu()
+	cpu.SetType("Intel Xeon E3-1230 v6")
+	specs.SetCpu(cpu)
+	ram := specs.GetRam()
+	ram.SetSize(64)
+	ram.SetUnit("GB")
+	specs.SetRam(ram)
+	server.SetSpecs(specs)
+
+	rack := server.GetRack()
+	rack.SetType(dedicatedserver.AllowedRackTypeEnumValues[0])
+	server.SetRack(rack)
+
+	contract := server.GetContract()
+	contract.SetId("67890")
+	contract.SetStatus(dedicatedserver.AllowedContractStatusEnumValues[0])
+	server.SetContract(contract)
+
+	return server
+}
+
+func Test_ramSizeInGB(t *testing.T) {
+	tests := []struct {
+		name string
+		size int32
+		unit string
+		want int32
+	}{
+		{name: "KB is converted to GB", size: 2097152, unit: "KB", want: 2},
+		{name: "MB is converted to GB", size: 65536, unit: "MB", want: 64},
+		{name: "MB below 1 GB is rounded down", size: 512, unit: "MB", want: 0},
+		{name: "GB is returned as is", size: 64, unit: "GB", want: 64},
+		{name: "TB is converted to GB", size: 2, unit: "TB", want: 2048},
+		{name: "unit is case insensitive", size: 1, unit: "tb", want: 1024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ramSizeInGB(tt.size, tt.unit))
+		})
+	}
+}
+
+func Test_serverFilter_matches(t *testing.T) {
+	server := newFilterTestServer()
+	rackType := string(dedicatedserver.AllowedRackTypeEnumValues[0])
+	contractStatus := string(dedicatedserver.AllowedContractStatusEnumValues[0])
+
+	tests := []struct {
+		name   string
+		filter serverFilter
+		want   bool
+	}{
+		{
+			name: "server matches without filters",
+			filter: serverFilter{
+				cpuType:        basetypes.NewStringNull(),
+				minRAM:         basetypes.NewInt32Null(),
+				rackType:       basetypes.NewStringNull(),
+				contractStatus: basetypes.NewStringNull(),
+			},
+			want: true,
+		},
+		{
+			name: "server matches all filters",
+			filter: serverFilter{
+				cpuType:        basetypes.NewStringValue("xeon"),
+				minRAM:         basetypes.NewInt32Value(64),
+				rackType:       basetypes.NewStringValue(rackType),
+				contractStatus: basetypes.NewStringValue(contractStatus),
+			},
+			want: true,
+		},
+		{
+			name: "cpu type does not match",
+			filter: serverFilter{
+				cpuType:        basetypes.NewStringValue("epyc"),
+				minRAM:         basetypes.NewInt32Null(),
+				rackType:       basetypes.NewStringNull(),
+				contractStatus: basetypes.NewStringNull(),
+			},
+			want: false,
+		},
+		{
+			name: "server has less ram",
+			filter: serverFilter{
+				cpuType:        basetypes.NewStringNull(),
+				minRAM:         basetypes.NewInt32Value(128),
+				rackType:       basetypes.NewStringNull(),
+				contractStatus: basetypes.NewStringNull(),
+			},
+			want: false,
+		},
+		{
+			name: "rack type does not match",
+			filter: serverFilter{
+				cpuType:        basetypes.NewStringNull(),
+				minRAM:         basetypes.NewInt32Null(),
+				rackType:       basetypes.NewStringValue("rackType"),
+				contractStatus: basetypes.NewStringNull(),
+			},
+			want: false,
+		},
+		{
+			name: "contract status does not match",
+			filter: serverFilter{
+				cpuType:        basetypes.NewStringNull(),
+				minRAM:         basetypes.NewInt32Null(),
+				rackType:       basetypes.NewStringNull(),
+				contractStatus: basetypes.NewStringValue("contractStatus"),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.matches(server))
+		})
+	}
+}
+
+func Test_adaptServerToServerDataSource(t *testing.T) {
+	got := adaptServerToServerDataSource(newFilterTestServer())
+
+	assert.Equal(t, basetypes.NewStringValue("12345"), got.ID)
+	assert.Equal(t, basetypes.NewStringValue("67890"), got.ContractID)
+	assert.Equal(
+		t,
+		basetypes.NewStringValue(string(dedicatedserver.AllowedContractStatusEnumValues[0])),
+		got.ContractStatus,
+	)
+	assert.Equal(t, basetypes.NewStringValue("Intel Xeon E3-1230 v6"), got.CPUType)
+	assert.Equal(t, basetypes.NewInt32Value(64), got.RAMSize)
+	assert.Equal(t, basetypes.NewStringValue("GB"), got.RAMUnit)
+}
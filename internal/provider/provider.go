@@ -165,11 +165,24 @@ func (p *leasewebProvider) DataSources(_ context.Context) []func() datasource.Da
 		dedicatedserver.NewControlPanelsDataSource,
 		dedicatedserver.NewOperatingSystemsDataSource,
 		dedicatedserver.NewCredentialDataSource,
+		dedicatedserver.NewRescueImagesDataSource,
+		dedicatedserver.NewHardwareDataSource,
+		dedicatedserver.NewBandwidthMetricsDataSource,
+		dedicatedserver.NewDatatrafficMetricsDataSource,
 		publiccloud.NewImagesDataSource,
 		publiccloud.NewLoadBalancersDataSource,
 		publiccloud.NewLoadBalancerListenersDataSource,
 		publiccloud.NewTargetGroupsDataSource,
 		publiccloud.NewISOsDataSource,
+		publiccloud.NewAutoscalingGroupsDataSource,
+		publiccloud.NewInstanceConsoleDataSource,
+		publiccloud.NewInstanceTypesDataSource,
+		publiccloud.NewRegionsDataSource,
+		publiccloud.NewMarketAppsDataSource,
+		publiccloud.NewInstanceMetricsDataSource,
+		publiccloud.NewLoadBalancerMetricsDataSource,
+		publiccloud.NewExpensesDataSource,
+		publiccloud.NewImageDataSource,
 		dns.NewResourceRecordSetsDataSource,
 		ipmgmt.NewIPsDataSource,
 		ipmgmt.NewNullRouteHistoryDataSource,
@@ -185,12 +198,20 @@ func (p *leasewebProvider) Resources(_ context.Context) []func() resource.Resour
 		dedicatedserver.NewNotificationSettingDatatrafficResource,
 		dedicatedserver.NewNotificationSettingBandwidthResource,
 		dedicatedserver.NewInstallationResource,
+		dedicatedserver.NewRescueModeResource,
+		dedicatedserver.NewHardwareScanResource,
+		dedicatedserver.NewPowerActionResource,
+		dedicatedserver.NewDHCPReservationResource,
+		dedicatedserver.NewPrivateNetworkResource,
+		dedicatedserver.NewNetworkInterfaceResource,
 		publiccloud.NewImageResource,
 		publiccloud.NewLoadBalancerResource,
 		publiccloud.NewLoadBalancerListenerResource,
 		publiccloud.NewTargetGroupResource,
 		publiccloud.NewIPResource,
 		publiccloud.NewInstanceIsoResource,
+		publiccloud.NewAutoscalingGroupResource,
+		publiccloud.NewInstancePrivateNetworkResource,
 		dns.NewResourceRecordSetsResource,
 		ipmgmt.NewIPResource,
 		ipmgmt.NewNullRouteResource,
@@ -0,0 +1,709 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &autoscalingGroupResource{}
+	_ resource.ResourceWithImportState = &autoscalingGroupResource{}
+)
+
+type autoscalingGroupResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	InstanceID    types.String `tfsdk:"instance_id"`
+	Reference     types.String `tfsdk:"reference"`
+	Region        types.String `tfsdk:"region"`
+	State         types.String `tfsdk:"state"`
+	DesiredAmount types.Int32  `tfsdk:"desired_amount"`
+	MinimumAmount types.Int32  `tfsdk:"minimum_amount"`
+	MaximumAmount types.Int32  `tfsdk:"maximum_amount"`
+	CPUThreshold  types.Int32  `tfsdk:"cpu_threshold"`
+	WarmupTime    types.Int32  `tfsdk:"warmup_time"`
+	CooldownTime  types.Int32  `tfsdk:"cooldown_time"`
+	StartsAt      types.String `tfsdk:"starts_at"`
+	EndsAt        types.String `tfsdk:"ends_at"`
+	TargetGroupID types.String `tfsdk:"target_group_id"`
+	Image         types.Object `tfsdk:"image"`
+	Contract      types.Object `tfsdk:"contract"`
+}
+
+func adaptAutoScalingGroupDetailsToAutoscalingGroupResource(
+	autoScalingGroupDetails publiccloud.AutoScalingGroupDetails,
+) autoscalingGroupResourceModel {
+	autoscalingGroup := autoscalingGroupResourceModel{
+		ID:            basetypes.NewStringValue(autoScalingGroupDetails.GetId()),
+		Type:          basetypes.NewStringValue(string(autoScalingGroupDetails.GetType())),
+		Reference:     basetypes.NewStringValue(autoScalingGroupDetails.GetReference()),
+		Region:        basetypes.NewStringValue(string(autoScalingGroupDetails.GetRegion())),
+		State:         basetypes.NewStringValue(string(autoScalingGroupDetails.GetState())),
+		DesiredAmount: basetypes.NewInt32PointerValue(autoScalingGroupDetails.DesiredAmount.Get()),
+		MinimumAmount: basetypes.NewInt32PointerValue(autoScalingGroupDetails.MinimumAmount.Get()),
+		MaximumAmount: basetypes.NewInt32PointerValue(autoScalingGroupDetails.MaximumAmount.Get()),
+		CPUThreshold:  basetypes.NewInt32PointerValue(autoScalingGroupDetails.CpuThreshold.Get()),
+		WarmupTime:    basetypes.NewInt32PointerValue(autoScalingGroupDetails.WarmupTime.Get()),
+		CooldownTime:  basetypes.NewInt32PointerValue(autoScalingGroupDetails.CooldownTime.Get()),
+		StartsAt:      utils.AdaptNullableTimeToRFC3339StringValue(autoScalingGroupDetails.StartsAt.Get()),
+		EndsAt:        utils.AdaptNullableTimeToRFC3339StringValue(autoScalingGroupDetails.EndsAt.Get()),
+		TargetGroupID: basetypes.NewStringNull(),
+		Image:         types.ObjectNull(imageResourceModel{}.attributeTypes()),
+		Contract:      types.ObjectNull(contractResourceModel{}.attributeTypes()),
+	}
+
+	if len(autoScalingGroupDetails.TargetGroups) > 0 {
+		autoscalingGroup.TargetGroupID = basetypes.NewStringValue(
+			autoScalingGroupDetails.TargetGroups[0].GetId(),
+		)
+	}
+
+	return autoscalingGroup
+}
+
+// adaptInstanceDetailsToAutoscalingGroupResource sets the image & contract
+// of the instance that the auto scaled instances are based on.
+func adaptInstanceDetailsToAutoscalingGroupResource(
+	ctx context.Context,
+	instanceDetails publiccloud.InstanceDetails,
+	autoscalingGroup *autoscalingGroupResourceModel,
+	diags *diag.Diagnostics,
+) {
+	autoscalingGroup.Image = utils.AdaptSdkModelToResourceObject(
+		instanceDetails.Image,
+		imageResourceModel{}.attributeTypes(),
+		ctx,
+		adaptImageToImageResource,
+		diags,
+	)
+	autoscalingGroup.Contract = utils.AdaptSdkModelToResourceObject(
+		instanceDetails.Contract,
+		contractResourceModel{}.attributeTypes(),
+		ctx,
+		adaptContractToContractResource,
+		diags,
+	)
+}
+
+var utcDateTimeRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)
+
+// adaptRFC3339StringValueToNullableTime parses the configured time. Errors are
+// attached to the attribute at attributePath.
+func adaptRFC3339StringValueToNullableTime(
+	value types.String,
+	attributePath path.Path,
+	diags *diag.Diagnostics,
+) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf(
+				"Expected a RFC3339 formatted date & time, got: %q",
+				value.ValueString(),
+			),
+		)
+		return nil
+	}
+
+	return &parsedTime
+}
+
+// waitForAutoScalingGroupState polls the auto scaling group until its state
+// is one of the passed states.
+func waitForAutoScalingGroupState(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	id string,
+	states []publiccloud.AutoScalingGroupState,
+	diags *diag.Diagnostics,
+) *publiccloud.AutoScalingGroupDetails {
+	pollState := func() (*publiccloud.AutoScalingGroupDetails, error) {
+		autoScalingGroupDetails, httpResponse, err := api.
+			GetAutoScalingGroup(ctx, id).
+			Execute()
+		if err != nil {
+			// A destroyed auto scaling group might not be returned anymore.
+			if httpResponse != nil &&
+				httpResponse.StatusCode == http.StatusNotFound &&
+				slices.Contains(states, publiccloud.AUTOSCALINGGROUPSTATE_DESTROYED) {
+				return nil, nil
+			}
+
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil, backoff.Permanent(err)
+		}
+
+		if !slices.Contains(states, autoScalingGroupDetails.GetState()) {
+			return nil, fmt.Errorf(
+				"auto scaling group %s has state %s",
+				id,
+				autoScalingGroupDetails.GetState(),
+			)
+		}
+
+		return autoScalingGroupDetails, nil
+	}
+
+	autoScalingGroupDetails, err := backoff.Retry(
+		ctx,
+		pollState,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+
+	return autoScalingGroupDetails
+}
+
+// settledAutoScalingGroupStates are the states in which an auto scaling group
+// is no longer being created, scaled or updated. Scheduled groups stay CREATED
+// until their start time has been reached.
+var settledAutoScalingGroupStates = []publiccloud.AutoScalingGroupState{
+	publiccloud.AUTOSCALINGGROUPSTATE_ACTIVE,
+	publiccloud.AUTOSCALINGGROUPSTATE_CREATED,
+}
+
+type autoscalingGroupResource struct {
+	utils.ResourceAPI
+}
+
+// setTemplateInstance adds the image & contract of the instance the auto
+// scaled instances are based on. They stay null if the instance is unknown,
+// i.e. after an import, or has been deleted since.
+func (a *autoscalingGroupResource) setTemplateInstance(
+	ctx context.Context,
+	autoscalingGroup *autoscalingGroupResourceModel,
+	diags *diag.Diagnostics,
+) {
+	if autoscalingGroup.InstanceID.IsNull() || autoscalingGroup.InstanceID.IsUnknown() {
+		return
+	}
+
+	instanceDetails, httpResponse, err := a.PubliccloudAPI.
+		GetInstance(ctx, autoscalingGroup.InstanceID.ValueString()).
+		Execute()
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return
+	}
+
+	adaptInstanceDetailsToAutoscalingGroupResource(
+		ctx,
+		*instanceDetails,
+		autoscalingGroup,
+		diags,
+	)
+}
+
+func (a *autoscalingGroupResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (a *autoscalingGroupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause this auto scaling group to be destroyed and a new one to be created."
+
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The auto scaling group unique identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf(
+					"%s Valid options are %s",
+					warningError,
+					utils.StringTypeArrayToMarkdown(publiccloud.AllowedAutoScalingGroupTypeEnumValues),
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedAutoScalingGroupTypeEnumValues)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The instance on which the auto scaled instances will be based. The instance must have state *RUNNING* or *STOPPED*. " + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference": schema.StringAttribute{
+				Required:    true,
+				Description: "The identifying name set to the auto scaling group",
+			},
+			"region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The auto scaling group's current state",
+			},
+			"desired_amount": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Required for *MANUAL* and *SCHEDULED* auto scaling groups. The number of instances that should be running",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"minimum_amount": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Required for *CPU_BASED* auto scaling groups. The minimum number of instances that should be running",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_amount": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Required for *CPU_BASED* auto scaling groups. The maximum number of instances that can be running",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cpu_threshold": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Required for *CPU_BASED* auto scaling groups. The target average CPU utilization for scaling",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"warmup_time": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Required for *CPU_BASED* auto scaling groups. Warm-up time in seconds for new instances",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cooldown_time": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Required for *CPU_BASED* auto scaling groups. Cool-down time in seconds for new instances",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "Required for *SCHEDULED* auto scaling groups. Date and time (UTC, RFC3339 formatted) that the instances need to be launched. Must be changed along with `ends_at`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utcDateTimeRegexp, "must be a RFC3339 formatted date & time in UTC, i.e.: 2025-01-02T15:04:05Z"),
+					stringvalidator.AlsoRequires(path.MatchRoot("ends_at")),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "Required for *SCHEDULED* auto scaling groups. Date and time (UTC, RFC3339 formatted) that the instances need to be terminated. Must be changed along with `starts_at`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utcDateTimeRegexp, "must be a RFC3339 formatted date & time in UTC, i.e.: 2025-01-02T15:04:05Z"),
+					stringvalidator.AlsoRequires(path.MatchRoot("starts_at")),
+				},
+			},
+			"target_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The target group the auto scaled instances are registered in",
+			},
+			"image": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The image of `instance_id`, which the auto scaled instances are launched with",
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"instance_id": schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Computed: true},
+					"custom": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Standard or Custom image",
+					},
+					"state": schema.StringAttribute{Computed: true},
+					"market_apps": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"storage_types": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"flavour": schema.StringAttribute{Computed: true},
+					"region":  schema.StringAttribute{Computed: true},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"contract": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The contract of `instance_id`, which the auto scaled instances are launched with",
+				Attributes: map[string]schema.Attribute{
+					"billing_frequency": schema.Int32Attribute{Computed: true},
+					"term":              schema.Int32Attribute{Computed: true},
+					"type":              schema.StringAttribute{Computed: true},
+					"ends_at":           schema.StringAttribute{Computed: true},
+					"state":             schema.StringAttribute{Computed: true},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (a *autoscalingGroupResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan autoscalingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	opts := publiccloud.NewCreateAutoScalingGroupOpts(
+		plan.InstanceID.ValueString(),
+		plan.Reference.ValueString(),
+		plan.Type.ValueString(),
+	)
+	opts.DesiredAmount = utils.AdaptInt32PointerValueToNullableInt32(plan.DesiredAmount)
+	opts.MinimumAmount = utils.AdaptInt32PointerValueToNullableInt32(plan.MinimumAmount)
+	opts.MaximumAmount = utils.AdaptInt32PointerValueToNullableInt32(plan.MaximumAmount)
+	opts.CpuThreshold = utils.AdaptInt32PointerValueToNullableInt32(plan.CPUThreshold)
+	opts.WarmupTime = utils.AdaptInt32PointerValueToNullableInt32(plan.WarmupTime)
+	opts.CooldownTime = utils.AdaptInt32PointerValueToNullableInt32(plan.CooldownTime)
+	opts.StartsAt = adaptRFC3339StringValueToNullableTime(
+		plan.StartsAt,
+		path.Root("starts_at"),
+		&response.Diagnostics,
+	)
+	opts.EndsAt = adaptRFC3339StringValueToNullableTime(
+		plan.EndsAt,
+		path.Root("ends_at"),
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	autoScalingGroupDetails, httpResponse, err := a.PubliccloudAPI.
+		CreateAutoScalingGroup(ctx).
+		CreateAutoScalingGroupOpts(*opts).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	// Save the ID straight away so the group is not lost if waiting fails.
+	response.Diagnostics.Append(response.State.SetAttribute(
+		ctx,
+		path.Root("id"),
+		autoScalingGroupDetails.GetId(),
+	)...)
+
+	autoScalingGroupDetails = waitForAutoScalingGroupState(
+		ctx,
+		a.PubliccloudAPI,
+		autoScalingGroupDetails.GetId(),
+		settledAutoScalingGroupStates,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TargetGroupID.IsNull() {
+		autoScalingGroupDetails = a.registerTargetGroup(
+			ctx,
+			autoScalingGroupDetails.GetId(),
+			plan.TargetGroupID.ValueString(),
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state := adaptAutoScalingGroupDetailsToAutoscalingGroupResource(*autoScalingGroupDetails)
+	// instanceId has to be set manually as it isn't returned from the API
+	state.InstanceID = plan.InstanceID
+	a.setTemplateInstance(ctx, &state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (a *autoscalingGroupResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var currentState autoscalingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	autoScalingGroupDetails, httpResponse, err := a.PubliccloudAPI.
+		GetAutoScalingGroup(ctx, currentState.ID.ValueString()).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptAutoScalingGroupDetailsToAutoscalingGroupResource(*autoScalingGroupDetails)
+	// instanceId has to be set manually as it isn't returned from the API
+	state.InstanceID = currentState.InstanceID
+	a.setTemplateInstance(ctx, &state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (a *autoscalingGroupResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	var plan autoscalingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var currentState autoscalingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	opts := publiccloud.NewUpdateAutoScalingGroupOpts()
+	opts.Reference = utils.AdaptStringPointerValueToNullableString(plan.Reference)
+	opts.DesiredAmount = utils.AdaptInt32PointerValueToNullableInt32(plan.DesiredAmount)
+	opts.MinimumAmount = utils.AdaptInt32PointerValueToNullableInt32(plan.MinimumAmount)
+	opts.MaximumAmount = utils.AdaptInt32PointerValueToNullableInt32(plan.MaximumAmount)
+	opts.CpuThreshold = utils.AdaptInt32PointerValueToNullableInt32(plan.CPUThreshold)
+	opts.WarmupTime = utils.AdaptInt32PointerValueToNullableInt32(plan.WarmupTime)
+	opts.CooldownTime = utils.AdaptInt32PointerValueToNullableInt32(plan.CooldownTime)
+	opts.StartsAt = adaptRFC3339StringValueToNullableTime(
+		plan.StartsAt,
+		path.Root("starts_at"),
+		&response.Diagnostics,
+	)
+	opts.EndsAt = adaptRFC3339StringValueToNullableTime(
+		plan.EndsAt,
+		path.Root("ends_at"),
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResponse, err := a.PubliccloudAPI.
+		UpdateAutoScalingGroup(ctx, plan.ID.ValueString()).
+		UpdateAutoScalingGroupOpts(*opts).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	autoScalingGroupDetails := waitForAutoScalingGroupState(
+		ctx,
+		a.PubliccloudAPI,
+		plan.ID.ValueString(),
+		settledAutoScalingGroupStates,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TargetGroupID.Equal(currentState.TargetGroupID) {
+		if !currentState.TargetGroupID.IsNull() {
+			autoScalingGroupDetails = a.deregisterTargetGroup(
+				ctx,
+				plan.ID.ValueString(),
+				currentState.TargetGroupID.ValueString(),
+				&response.Diagnostics,
+			)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !plan.TargetGroupID.IsNull() {
+			autoScalingGroupDetails = a.registerTargetGroup(
+				ctx,
+				plan.ID.ValueString(),
+				plan.TargetGroupID.ValueString(),
+				&response.Diagnostics,
+			)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	state := adaptAutoScalingGroupDetailsToAutoscalingGroupResource(*autoScalingGroupDetails)
+	// instanceId has to be set manually as it isn't returned from the API
+	state.InstanceID = plan.InstanceID
+	a.setTemplateInstance(ctx, &state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (a *autoscalingGroupResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var state autoscalingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := a.PubliccloudAPI.DeleteAutoScalingGroup(
+		ctx,
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	waitForAutoScalingGroupState(
+		ctx,
+		a.PubliccloudAPI,
+		state.ID.ValueString(),
+		[]publiccloud.AutoScalingGroupState{publiccloud.AUTOSCALINGGROUPSTATE_DESTROYED},
+		&response.Diagnostics,
+	)
+}
+
+func (a *autoscalingGroupResource) registerTargetGroup(
+	ctx context.Context,
+	id string,
+	targetGroupID string,
+	diags *diag.Diagnostics,
+) *publiccloud.AutoScalingGroupDetails {
+	_, httpResponse, err := a.PubliccloudAPI.
+		RegisterAutoScalingGroupTargetGroup(ctx, id).
+		TargetGroupIdOpts(*publiccloud.NewTargetGroupIdOpts(targetGroupID)).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
+	}
+
+	return waitForAutoScalingGroupState(
+		ctx,
+		a.PubliccloudAPI,
+		id,
+		settledAutoScalingGroupStates,
+		diags,
+	)
+}
+
+func (a *autoscalingGroupResource) deregisterTargetGroup(
+	ctx context.Context,
+	id string,
+	targetGroupID string,
+	diags *diag.Diagnostics,
+) *publiccloud.AutoScalingGroupDetails {
+	_, httpResponse, err := a.PubliccloudAPI.
+		DeregisterAutoScalingGroupTargetGroup(ctx, id).
+		TargetGroupIdOpts(*publiccloud.NewTargetGroupIdOpts(targetGroupID)).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
+	}
+
+	return waitForAutoScalingGroupState(
+		ctx,
+		a.PubliccloudAPI,
+		id,
+		settledAutoScalingGroupStates,
+		diags,
+	)
+}
+
+func NewAutoscalingGroupResource() resource.Resource {
+	return &autoscalingGroupResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "public_cloud_autoscaling_group",
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package publiccloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptAutoScalingGroupDetailsToAutoscalingGroupResource(t *testing.T) {
+	t.Run("main fields are set", func(t *testing.T) {
+		startsAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		desiredAmount := int32(2)
+		sdkAutoScalingGroup := publiccloud.AutoScalingGroupDetails{
+			Id:            "id",
+			Type:          publiccloud.AUTOSCALINGGROUPTYPE_SCHEDULED,
+			State:         publiccloud.AUTOSCALINGGROUPSTATE_ACTIVE,
+			DesiredAmount: *publiccloud.NewNullableInt32(&desiredAmount),
+			Region:        publiccloud.REGIONNAME_EU_WEST_3,
+			Reference:     "reference",
+			StartsAt:      *publiccloud.NewNullableTime(&startsAt),
+			TargetGroups: []publiccloud.TargetGroup{
+				{Id: "targetGroupId"},
+			},
+		}
+
+		got := adaptAutoScalingGroupDetailsToAutoscalingGroupResource(sdkAutoScalingGroup)
+
+		assert.Equal(t, "id", got.ID.ValueString())
+		assert.Equal(t, "SCHEDULED", got.Type.ValueString())
+		assert.Equal(t, "ACTIVE", got.State.ValueString())
+		assert.Equal(t, int32(2), got.DesiredAmount.ValueInt32())
+		assert.True(t, got.MaximumAmount.IsNull())
+		assert.Equal(t, "eu-west-3", got.Region.ValueString())
+		assert.Equal(t, "reference", got.Reference.ValueString())
+		assert.Equal(t, "2024-01-01T12:00:00Z", got.StartsAt.ValueString())
+		assert.True(t, got.EndsAt.IsNull())
+		assert.Equal(t, "targetGroupId", got.TargetGroupID.ValueString())
+		assert.True(t, got.Image.IsNull())
+		assert.True(t, got.Contract.IsNull())
+	})
+
+	t.Run("target group is null if not set", func(t *testing.T) {
+		got := adaptAutoScalingGroupDetailsToAutoscalingGroupResource(
+			publiccloud.AutoScalingGroupDetails{},
+		)
+
+		assert.True(t, got.TargetGroupID.IsNull())
+	})
+}
+
+func Test_adaptInstanceDetailsToAutoscalingGroupResource(t *testing.T) {
+	instanceDetails := publiccloud.InstanceDetails{
+		Image: publiccloud.Image{
+			Id:      "imageId",
+			Name:    "imageName",
+			Flavour: publiccloud.FLAVOUR_UBUNTU,
+		},
+		Contract: publiccloud.Contract{
+			Type:  publiccloud.CONTRACTTYPE_MONTHLY,
+			State: publiccloud.CONTRACTSTATE_ACTIVE,
+		},
+	}
+	autoscalingGroup := adaptAutoScalingGroupDetailsToAutoscalingGroupResource(
+		publiccloud.AutoScalingGroupDetails{},
+	)
+	diags := diag.Diagnostics{}
+
+	adaptInstanceDetailsToAutoscalingGroupResource(
+		context.TODO(),
+		instanceDetails,
+		&autoscalingGroup,
+		&diags,
+	)
+
+	assert.False(t, diags.HasError())
+
+	image := imageResourceModel{}
+	autoscalingGroup.Image.As(context.TODO(), &image, basetypes.ObjectAsOptions{})
+	assert.Equal(t, "imageId", image.ID.ValueString())
+	assert.Equal(t, "ubuntu", image.Flavour.ValueString())
+
+	contract := contractResourceModel{}
+	autoscalingGroup.Contract.As(
+		context.TODO(),
+		&contract,
+		basetypes.ObjectAsOptions{},
+	)
+	assert.Equal(t, "MONTHLY", contract.Type.ValueString())
+	assert.Equal(t, "ACTIVE", contract.State.ValueString())
+}
+
+func Test_adaptRFC3339StringValueToNullableTime(t *testing.T) {
+	t.Run("null value returns nil", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := adaptRFC3339StringValueToNullableTime(
+			basetypes.NewStringNull(),
+			path.Root("starts_at"),
+			&diags,
+		)
+
+		assert.Nil(t, got)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("valid value is parsed", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := adaptRFC3339StringValueToNullableTime(
+			basetypes.NewStringValue("2024-01-01T12:00:00Z"),
+			path.Root("starts_at"),
+			&diags,
+		)
+
+		assert.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), *got)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("invalid value sets an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := adaptRFC3339StringValueToNullableTime(
+			basetypes.NewStringValue("tralala"),
+			path.Root("starts_at"),
+			&diags,
+		)
+
+		assert.Nil(t, got)
+		assert.True(t, diags.HasError())
+	})
+}
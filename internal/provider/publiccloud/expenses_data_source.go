@@ -0,0 +1,382 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &expensesDataSource{}
+)
+
+var dateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+type expenseInstanceDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Reference           types.String `tfsdk:"reference"`
+	ContractType        types.String `tfsdk:"contract_type"`
+	BillingType         types.String `tfsdk:"billing_type"`
+	RootDiskSize        types.Int32  `tfsdk:"root_disk_size"`
+	RootDiskStorageType types.String `tfsdk:"root_disk_storage_type"`
+	StartedAt           types.String `tfsdk:"started_at"`
+	EndedAt             types.String `tfsdk:"ended_at"`
+	From                types.String `tfsdk:"from"`
+	To                  types.String `tfsdk:"to"`
+	Hours               types.Int32  `tfsdk:"hours"`
+	Price               types.String `tfsdk:"price"`
+}
+
+func adaptExpenseResultInstanceToExpenseInstanceDataSource(
+	instance publiccloud.ExpenseResultInstance,
+) expenseInstanceDataSourceModel {
+	expenseInstance := expenseInstanceDataSourceModel{
+		ID:                  basetypes.NewStringPointerValue(instance.Id),
+		Reference:           basetypes.NewStringPointerValue(instance.Reference),
+		ContractType:        basetypes.NewStringNull(),
+		BillingType:         basetypes.NewStringPointerValue(instance.BillingType),
+		RootDiskSize:        basetypes.NewInt32PointerValue(instance.RootDiskSize),
+		RootDiskStorageType: basetypes.NewStringNull(),
+		StartedAt:           utils.AdaptNullableTimeToRFC3339StringValue(instance.StartedAt),
+		EndedAt:             utils.AdaptNullableTimeToRFC3339StringValue(instance.EndedAt),
+		From:                utils.AdaptNullableTimeToRFC3339StringValue(instance.From),
+		To:                  utils.AdaptNullableTimeToRFC3339StringValue(instance.To),
+		Hours:               basetypes.NewInt32PointerValue(instance.Hours),
+		Price:               basetypes.NewStringPointerValue(instance.Price),
+	}
+
+	if contract, ok := instance.GetContractOk(); ok {
+		expenseInstance.ContractType = basetypes.NewStringValue(string(contract.GetType()))
+	}
+	if storageType, ok := instance.GetRootDiskStorageTypeOk(); ok {
+		expenseInstance.RootDiskStorageType = basetypes.NewStringValue(string(*storageType))
+	}
+
+	return expenseInstance
+}
+
+type expenseTrafficTierDataSourceModel struct {
+	Name  types.String  `tfsdk:"name"`
+	Usage types.Float64 `tfsdk:"usage"`
+	Price types.Float64 `tfsdk:"price"`
+}
+
+type expenseTrafficDataSourceModel struct {
+	Unit  types.String                        `tfsdk:"unit"`
+	Tiers []expenseTrafficTierDataSourceModel `tfsdk:"tiers"`
+}
+
+func adaptTrafficToExpenseTrafficDataSource(
+	traffic publiccloud.Traffic,
+) *expenseTrafficDataSourceModel {
+	expenseTraffic := expenseTrafficDataSourceModel{
+		Unit: basetypes.NewStringPointerValue(traffic.Unit),
+	}
+
+	values := traffic.GetValues()
+	tiers := []struct {
+		name string
+		tier *publiccloud.Tier
+	}{
+		{name: "tier_0", tier: values.Tier0},
+		{name: "tier_1", tier: values.Tier1},
+		{name: "tier_2", tier: values.Tier2},
+		{name: "tier_3", tier: values.Tier3},
+	}
+
+	for _, tier := range tiers {
+		if tier.tier == nil {
+			continue
+		}
+		expenseTraffic.Tiers = append(
+			expenseTraffic.Tiers,
+			expenseTrafficTierDataSourceModel{
+				Name:  basetypes.NewStringValue(tier.name),
+				Usage: basetypes.NewFloat64Value(float64(tier.tier.GetUsage())),
+				Price: basetypes.NewFloat64Value(float64(tier.tier.GetPrice())),
+			},
+		)
+	}
+
+	return &expenseTraffic
+}
+
+type expenseProductDataSourceModel struct {
+	Name  types.String  `tfsdk:"name"`
+	Total types.Float64 `tfsdk:"total"`
+}
+
+type expensesDataSourceModel struct {
+	EquipmentID types.String                     `tfsdk:"equipment_id"`
+	From        types.String                     `tfsdk:"from"`
+	To          types.String                     `tfsdk:"to"`
+	Instances   []expenseInstanceDataSourceModel `tfsdk:"instances"`
+	Traffic     *expenseTrafficDataSourceModel   `tfsdk:"traffic"`
+	Products    []expenseProductDataSourceModel  `tfsdk:"products"`
+	Total       types.Float64                    `tfsdk:"total"`
+}
+
+// calculateExpensesProducts adds up the costs per billed product. The API
+// returns instance prices as strings, so they have to be parsed first.
+func calculateExpensesProducts(
+	instances []expenseInstanceDataSourceModel,
+	traffic *expenseTrafficDataSourceModel,
+	diags *diag.Diagnostics,
+) []expenseProductDataSourceModel {
+	var products []expenseProductDataSourceModel
+
+	if len(instances) > 0 {
+		total := float64(0)
+		for _, instance := range instances {
+			if instance.Price.IsNull() {
+				continue
+			}
+			price, err := strconv.ParseFloat(instance.Price.ValueString(), 64)
+			if err != nil {
+				diags.AddError(
+					"Unable to calculate total expenses",
+					fmt.Sprintf(
+						"price %q of instance %q is not a number",
+						instance.Price.ValueString(),
+						instance.ID.ValueString(),
+					),
+				)
+				return nil
+			}
+			total += price
+		}
+		products = append(products, expenseProductDataSourceModel{
+			Name:  basetypes.NewStringValue("instances"),
+			Total: basetypes.NewFloat64Value(total),
+		})
+	}
+
+	if traffic != nil {
+		total := float64(0)
+		for _, tier := range traffic.Tiers {
+			total += tier.Price.ValueFloat64()
+		}
+		products = append(products, expenseProductDataSourceModel{
+			Name:  basetypes.NewStringValue("traffic"),
+			Total: basetypes.NewFloat64Value(total),
+		})
+	}
+
+	return products
+}
+
+// calculateExpensesTotal adds up the costs of all products.
+func calculateExpensesTotal(
+	products []expenseProductDataSourceModel,
+) basetypes.Float64Value {
+	total := float64(0)
+	for _, product := range products {
+		total += product.Total.ValueFloat64()
+	}
+
+	return basetypes.NewFloat64Value(total)
+}
+
+type expensesDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (e *expensesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"equipment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the instance or load balancer to get the costs for.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "Start date of the billing period, e.g. `2025-01-01`. It must be the first day of the month.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegexp, "must be a date, i.e.: 2025-01-01"),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "End date of the billing period, e.g. `2025-02-01`. It must be exactly one month after `from`. If not set, it is calculated based on `from`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateRegexp, "must be a date, i.e.: 2025-02-01"),
+				},
+			},
+			"instances": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The costs per instance",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The instance unique identifier",
+						},
+						"reference": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifying name set to the instance",
+						},
+						"contract_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"billing_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"root_disk_size": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The root disk's size in GB",
+						},
+						"root_disk_storage_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"started_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the instance was started for the first time",
+						},
+						"ended_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the instance was terminated",
+						},
+						"from": schema.StringAttribute{
+							Computed:    true,
+							Description: "Start date and time of the billed period",
+						},
+						"to": schema.StringAttribute{
+							Computed:    true,
+							Description: "End date and time of the billed period",
+						},
+						"hours": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The number of hours billed",
+						},
+						"price": schema.StringAttribute{
+							Computed:    true,
+							Description: "The cost of the instance for the billed period",
+						},
+					},
+				},
+			},
+			"traffic": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The costs of the used data traffic",
+				Attributes: map[string]schema.Attribute{
+					"unit": schema.StringAttribute{
+						Computed: true,
+					},
+					"tiers": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"usage": schema.Float64Attribute{
+									Computed: true,
+								},
+								"price": schema.Float64Attribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"products": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The costs per billed product, i.e. *instances* & *traffic*",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"total": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The sum of the costs of the product",
+						},
+					},
+				},
+			},
+			"total": schema.Float64Attribute{
+				Computed:    true,
+				Description: "The sum of the instance and traffic costs billed so far. A projection of the spend for the whole month is not provided.",
+			},
+		},
+	}
+}
+
+func (e *expensesDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config expensesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	expensesRequest := e.PubliccloudAPI.
+		GetExpenses(ctx, config.EquipmentID.ValueString()).
+		From(config.From.ValueString())
+	if !config.To.IsNull() {
+		expensesRequest = expensesRequest.To(config.To.ValueString())
+	}
+
+	result, httpResponse, err := expensesRequest.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := expensesDataSourceModel{
+		EquipmentID: config.EquipmentID,
+		From:        config.From,
+		To:          config.To,
+	}
+
+	billing := result.GetBilling()
+	for _, instance := range billing.GetInstances() {
+		state.Instances = append(
+			state.Instances,
+			adaptExpenseResultInstanceToExpenseInstanceDataSource(instance),
+		)
+	}
+	if traffic, ok := billing.GetTrafficOk(); ok {
+		state.Traffic = adaptTrafficToExpenseTrafficDataSource(*traffic)
+	}
+
+	state.Products = calculateExpensesProducts(
+		state.Instances,
+		state.Traffic,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	state.Total = calculateExpensesTotal(state.Products)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func NewExpensesDataSource() datasource.DataSource {
+	return &expensesDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_expenses",
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptExpenseResultInstanceToExpenseInstanceDataSource(t *testing.T) {
+	t.Run("all fields are set", func(t *testing.T) {
+		id := "b778824a-a96e-4f6f-9713-7b7196f09c4f"
+		reference := "test-instance"
+		billingType := "POSTPAID"
+		rootDiskSize := int32(15)
+		storageType := publiccloud.STORAGETYPE_CENTRAL
+		hours := int32(14)
+		price := "0.72"
+		from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+
+		got := adaptExpenseResultInstanceToExpenseInstanceDataSource(
+			publiccloud.ExpenseResultInstance{
+				Id:                  &id,
+				Reference:           &reference,
+				Contract:            &publiccloud.Contract{Type: publiccloud.CONTRACTTYPE_HOURLY},
+				BillingType:         &billingType,
+				RootDiskSize:        &rootDiskSize,
+				RootDiskStorageType: &storageType,
+				Hours:               &hours,
+				From:                &from,
+				Price:               &price,
+			},
+		)
+
+		assert.Equal(t, id, got.ID.ValueString())
+		assert.Equal(t, "test-instance", got.Reference.ValueString())
+		assert.Equal(t, "HOURLY", got.ContractType.ValueString())
+		assert.Equal(t, "POSTPAID", got.BillingType.ValueString())
+		assert.Equal(t, int32(15), got.RootDiskSize.ValueInt32())
+		assert.Equal(t, "CENTRAL", got.RootDiskStorageType.ValueString())
+		assert.Equal(t, int32(14), got.Hours.ValueInt32())
+		assert.Equal(t, "2023-12-01T00:00:00Z", got.From.ValueString())
+		assert.True(t, got.EndedAt.IsNull())
+		assert.Equal(t, "0.72", got.Price.ValueString())
+	})
+
+	t.Run("contract_type is null without a contract", func(t *testing.T) {
+		got := adaptExpenseResultInstanceToExpenseInstanceDataSource(
+			publiccloud.ExpenseResultInstance{},
+		)
+
+		assert.True(t, got.ContractType.IsNull())
+		assert.True(t, got.RootDiskStorageType.IsNull())
+	})
+}
+
+func Test_adaptTrafficToExpenseTrafficDataSource(t *testing.T) {
+	unit := "GB"
+	usage := float32(34.5)
+	price := float32(2)
+
+	got := adaptTrafficToExpenseTrafficDataSource(publiccloud.Traffic{
+		Unit: &unit,
+		Values: &publiccloud.Values{
+			Tier3: &publiccloud.Tier{Usage: &usage, Price: &price},
+		},
+	})
+
+	assert.Equal(t, "GB", got.Unit.ValueString())
+	assert.Len(t, got.Tiers, 1)
+	assert.Equal(t, "tier_3", got.Tiers[0].Name.ValueString())
+	assert.Equal(t, float64(34.5), got.Tiers[0].Usage.ValueFloat64())
+	assert.Equal(t, float64(2), got.Tiers[0].Price.ValueFloat64())
+}
+
+func Test_calculateExpensesProducts(t *testing.T) {
+	t.Run("costs are added up per product", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := calculateExpensesProducts(
+			[]expenseInstanceDataSourceModel{
+				{Price: basetypes.NewStringValue("0.75")},
+				{Price: basetypes.NewStringNull()},
+				{Price: basetypes.NewStringValue("1.25")},
+			},
+			&expenseTrafficDataSourceModel{
+				Tiers: []expenseTrafficTierDataSourceModel{
+					{Price: basetypes.NewFloat64Value(3)},
+				},
+			},
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Len(t, got, 2)
+		assert.Equal(t, "instances", got[0].Name.ValueString())
+		assert.Equal(t, float64(2), got[0].Total.ValueFloat64())
+		assert.Equal(t, "traffic", got[1].Name.ValueString())
+		assert.Equal(t, float64(3), got[1].Total.ValueFloat64())
+	})
+
+	t.Run("products without costs are skipped", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := calculateExpensesProducts(nil, nil, &diags)
+
+		assert.False(t, diags.HasError())
+		assert.Empty(t, got)
+	})
+
+	t.Run("an invalid price returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := calculateExpensesProducts(
+			[]expenseInstanceDataSourceModel{
+				{
+					ID:    basetypes.NewStringValue("id"),
+					Price: basetypes.NewStringValue("tbd"),
+				},
+			},
+			nil,
+			&diags,
+		)
+
+		assert.True(t, diags.HasError())
+		assert.Nil(t, got)
+	})
+}
+
+func Test_calculateExpensesTotal(t *testing.T) {
+	got := calculateExpensesTotal([]expenseProductDataSourceModel{
+		{Total: basetypes.NewFloat64Value(2)},
+		{Total: basetypes.NewFloat64Value(3)},
+	})
+
+	assert.Equal(t, float64(5), got.ValueFloat64())
+}
@@ -0,0 +1,163 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &imageDataSource{}
+)
+
+type imageDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Custom       types.Bool   `tfsdk:"custom"`
+	State        types.String `tfsdk:"state"`
+	MarketApps   []string     `tfsdk:"market_apps"`
+	StorageTypes []string     `tfsdk:"storage_types"`
+	Flavour      types.String `tfsdk:"flavour"`
+	Region       types.String `tfsdk:"region"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	StorageType  types.String `tfsdk:"storage_type"`
+	MarketApp    types.String `tfsdk:"market_app"`
+	MostRecent   types.Bool   `tfsdk:"most_recent"`
+}
+
+// findImage returns the image that matches the filter. Multiple matches are
+// only allowed if mostRecent is set.
+func findImage(
+	images imageDetailsList,
+	filter imageFilter,
+	mostRecent bool,
+) (*publiccloud.ImageDetails, error) {
+	images = images.filter(filter)
+
+	switch {
+	case len(images) == 0:
+		return nil, fmt.Errorf("no image matches the filters")
+	case len(images) > 1 && !mostRecent:
+		return nil, fmt.Errorf(
+			"%d images match the filters, narrow down the filters or set most_recent to true",
+			len(images),
+		)
+	}
+
+	return images.findMostRecent(), nil
+}
+
+type imageDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (i *imageDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	attributes := imageSchemaAttributes()
+	for name, attribute := range imageFilterSchemaAttributes() {
+		attributes[name] = attribute
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Only match images with exactly this name.",
+	}
+	attributes["flavour"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Only match images with this flavour. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedFlavourEnumValues),
+		Validators: []validator.String{
+			stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedFlavourEnumValues)...),
+		},
+	}
+	attributes["custom"] = schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Only match custom images if true or standard images if false.",
+	}
+	attributes["region"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Only match images in this region. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+		Validators: []validator.String{
+			stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+		},
+	}
+	attributes["most_recent"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "If several images match the filters, return the most recently created one instead of throwing an error.",
+	}
+
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes:  attributes,
+	}
+}
+
+func (i *imageDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config imageDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex := compileNameRegex(config.NameRegex, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	filter := imageFilter{
+		name:        config.Name,
+		nameRegex:   nameRegex,
+		flavour:     config.Flavour,
+		custom:      config.Custom,
+		region:      config.Region,
+		storageType: config.StorageType,
+		marketApp:   config.MarketApp,
+	}
+	images := getAllImages(ctx, i.PubliccloudAPI, filter, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	imageDetails, err := findImage(images, filter, config.MostRecent.ValueBool())
+	if err != nil {
+		response.Diagnostics.AddError("Image not found", err.Error())
+		return
+	}
+
+	image := adaptImageDetailsToImageDataSource(*imageDetails)
+	state := config
+	state.ID = image.ID
+	state.Name = image.Name
+	state.Custom = image.Custom
+	state.State = image.State
+	state.MarketApps = image.MarketApps
+	state.StorageTypes = image.StorageTypes
+	state.Flavour = image.Flavour
+	state.Region = image.Region
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewImageDataSource() datasource.DataSource {
+	return &imageDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_image",
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_findImage(t *testing.T) {
+	newImage := func(id string, name string, createdAt *time.Time) publiccloud.ImageDetails {
+		return publiccloud.ImageDetails{
+			Id:        id,
+			Name:      name,
+			Custom:    createdAt != nil,
+			CreatedAt: *publiccloud.NewNullableTime(createdAt),
+		}
+	}
+	older := time.Date(2024, 7, 5, 10, 44, 8, 0, time.UTC)
+	newer := time.Date(2024, 7, 5, 10, 54, 27, 0, time.UTC)
+	images := imageDetailsList{
+		newImage("1", "Custom image", nil),
+		newImage("2", "Custom image", &newer),
+		newImage("3", "Custom image", &older),
+		newImage("4", "Other image", &newer),
+	}
+	nameFilter := imageFilter{
+		name:        basetypes.NewStringValue("Custom image"),
+		flavour:     basetypes.NewStringNull(),
+		custom:      basetypes.NewBoolNull(),
+		region:      basetypes.NewStringNull(),
+		storageType: basetypes.NewStringNull(),
+		marketApp:   basetypes.NewStringNull(),
+	}
+
+	t.Run("most recent image is returned", func(t *testing.T) {
+		got, err := findImage(images, nameFilter, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2", got.GetId())
+	})
+
+	t.Run("several matches return an error", func(t *testing.T) {
+		_, err := findImage(images, nameFilter, false)
+
+		assert.ErrorContains(t, err, "3 images match the filters")
+	})
+
+	t.Run("single match is returned", func(t *testing.T) {
+		filter := nameFilter
+		filter.name = basetypes.NewStringValue("Other image")
+
+		got, err := findImage(images, filter, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "4", got.GetId())
+	})
+
+	t.Run("no match returns an error", func(t *testing.T) {
+		filter := nameFilter
+		filter.name = basetypes.NewStringValue("tralala")
+
+		_, err := findImage(images, filter, true)
+
+		assert.ErrorContains(t, err, "no image matches the filters")
+	})
+}
@@ -3,7 +3,11 @@ package publiccloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,6 +35,27 @@ type imageResourceModel struct {
 	Region       types.String `tfsdk:"region"`
 }
 
+func (i imageResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"instance_id":   types.StringType,
+		"name":          types.StringType,
+		"custom":        types.BoolType,
+		"state":         types.StringType,
+		"market_apps":   types.ListType{ElemType: types.StringType},
+		"storage_types": types.ListType{ElemType: types.StringType},
+		"flavour":       types.StringType,
+		"region":        types.StringType,
+	}
+}
+
+// imageWithTimeoutsResourceModel adds the timeouts to the image, as
+// imageResourceModel is also used for the image of instances.
+type imageWithTimeoutsResourceModel struct {
+	imageResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func adaptImageDetailsToImageResource(
 	ctx context.Context,
 	imageDetails publiccloud.ImageDetails,
@@ -70,12 +95,108 @@ func adaptImageDetailsToImageResource(
 	return &image
 }
 
+// defaultImageCreationTimeout limits how long to wait for a custom image to
+// be built if no create timeout is configured.
+const defaultImageCreationTimeout = 60 * time.Minute
+
+// getCustomImage returns the custom image with the passed id or nil if it
+// does not exist. The API has no endpoint to retrieve a single image, so
+// only custom images are listed until the image is found.
+func getCustomImage(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	imageID string,
+	diags *diag.Diagnostics,
+) *publiccloud.ImageDetails {
+	request := api.GetImageList(ctx).Custom(true)
+
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil
+		}
+
+		if imageDetails := imageDetailsList(result.GetImages()).findById(imageID); imageDetails != nil {
+			return imageDetails
+		}
+
+		metadata := result.GetMetadata()
+		offset := utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			return nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+// waitForImageState polls the image until it is READY or FAILED. Images
+// that failed to build return an error containing the reason.
+func waitForImageState(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	imageID string,
+	timeout time.Duration,
+	diags *diag.Diagnostics,
+) *publiccloud.ImageDetails {
+	pollImage := func() (*publiccloud.ImageDetails, error) {
+		imageDetails := getCustomImage(ctx, api, imageID, diags)
+		if diags.HasError() {
+			return nil, backoff.Permanent(fmt.Errorf("cannot retrieve image %s", imageID))
+		}
+		if imageDetails == nil {
+			return nil, fmt.Errorf("image %s is not listed yet", imageID)
+		}
+
+		switch imageDetails.GetState() {
+		case publiccloud.IMAGESTATE_READY:
+			return imageDetails, nil
+		case publiccloud.IMAGESTATE_FAILED:
+			diags.AddError(
+				"Image creation failed",
+				fmt.Sprintf(
+					"image %s has state %s: %s",
+					imageID,
+					imageDetails.GetState(),
+					imageDetails.GetStateReason(),
+				),
+			)
+			return imageDetails, backoff.Permanent(
+				fmt.Errorf("image %s has state FAILED", imageID),
+			)
+		default:
+			return nil, fmt.Errorf(
+				"image %s has state %s",
+				imageID,
+				imageDetails.GetState(),
+			)
+		}
+	}
+
+	imageDetails, err := backoff.Retry(
+		ctx,
+		pollImage,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+		backoff.WithMaxElapsedTime(timeout),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+
+	return imageDetails
+}
+
 type imageResource struct {
 	utils.ResourceAPI
 }
 
 func (i *imageResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	response *resource.SchemaResponse,
 ) {
@@ -129,6 +250,12 @@ The id of the instance which the custom image is based on. The following rules a
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the custom image to be built, e.g. `90m`. Defaults to `60m`.",
+			}),
+		},
 	}
 
 	utils.AddUnsupportedActionsNotation(
@@ -142,12 +269,18 @@ func (i *imageResource) Create(
 	request resource.CreateRequest,
 	response *resource.CreateResponse,
 ) {
-	var plan imageResourceModel
+	var plan imageWithTimeoutsResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultImageCreationTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	imageDetails, httpResponse, err := i.PubliccloudAPI.CreateImage(ctx).
 		CreateImageOpts(
 			*publiccloud.NewCreateImageOpts(
@@ -161,16 +294,34 @@ func (i *imageResource) Create(
 		return
 	}
 
-	state := adaptImageDetailsToImageResource(
-		ctx,
-		*imageDetails,
-		&response.Diagnostics,
-	)
-	if response.Diagnostics.HasError() {
+	if imageDetails.GetState() != publiccloud.IMAGESTATE_READY {
+		createdImage := imageDetails
+		imageDetails = waitForImageState(
+			ctx,
+			i.PubliccloudAPI,
+			createdImage.GetId(),
+			createTimeout,
+			&response.Diagnostics,
+		)
+		// Store the image so that it is tainted instead of being lost.
+		if imageDetails == nil {
+			imageDetails = createdImage
+		}
+	}
+
+	adaptDiags := diag.Diagnostics{}
+	image := adaptImageDetailsToImageResource(ctx, *imageDetails, &adaptDiags)
+	response.Diagnostics.Append(adaptDiags...)
+	if adaptDiags.HasError() {
 		return
 	}
 	// instanceId has to be set manually as it isn't returned from the API
-	state.InstanceID = plan.InstanceID
+	image.InstanceID = plan.InstanceID
+
+	state := imageWithTimeoutsResourceModel{
+		imageResourceModel: *image,
+		Timeouts:           plan.Timeouts,
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -180,17 +331,21 @@ func (i *imageResource) Read(
 	request resource.ReadRequest,
 	response *resource.ReadResponse,
 ) {
-	var currentState imageResourceModel
+	var currentState imageWithTimeoutsResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	images := getAllImages(ctx, i.PubliccloudAPI, &response.Diagnostics)
+	imageDetails := getCustomImage(
+		ctx,
+		i.PubliccloudAPI,
+		currentState.ID.ValueString(),
+		&response.Diagnostics,
+	)
 	if response.Diagnostics.HasError() {
 		return
 	}
-	imageDetails := images.findById(currentState.ID.ValueString())
 	if imageDetails == nil {
 		utils.GeneralError(
 			&response.Diagnostics,
@@ -203,7 +358,7 @@ func (i *imageResource) Read(
 		return
 	}
 
-	state := adaptImageDetailsToImageResource(
+	image := adaptImageDetailsToImageResource(
 		ctx,
 		*imageDetails,
 		&response.Diagnostics,
@@ -212,7 +367,12 @@ func (i *imageResource) Read(
 		return
 	}
 	// instanceId has to be set manually as it isn't returned from the API
-	state.InstanceID = currentState.InstanceID
+	image.InstanceID = currentState.InstanceID
+
+	state := imageWithTimeoutsResourceModel{
+		imageResourceModel: *image,
+		Timeouts:           currentState.Timeouts,
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -222,7 +382,7 @@ func (i *imageResource) Update(
 	request resource.UpdateRequest,
 	response *resource.UpdateResponse,
 ) {
-	var plan imageResourceModel
+	var plan imageWithTimeoutsResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
@@ -238,7 +398,7 @@ func (i *imageResource) Update(
 		return
 	}
 
-	state := adaptImageDetailsToImageResource(
+	image := adaptImageDetailsToImageResource(
 		ctx,
 		*imageDetails,
 		&response.Diagnostics,
@@ -246,16 +406,38 @@ func (i *imageResource) Update(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	// instanceId has to be set manually as it isn't returned from the API
+	image.InstanceID = plan.InstanceID
+
+	state := imageWithTimeoutsResourceModel{
+		imageResourceModel: *image,
+		Timeouts:           plan.Timeouts,
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
-// Delete does nothing as there is no endpoint to delete an Image.
+// Delete only removes the image from state as there is no endpoint to
+// delete an Image.
 func (i *imageResource) Delete(
-	_ context.Context,
-	_ resource.DeleteRequest,
-	_ *resource.DeleteResponse,
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
 ) {
+	var state imageWithTimeoutsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.AddWarning(
+		"Image has not been deleted",
+		fmt.Sprintf(
+			"The API does not support deleting images. Custom image %s (%s) still exists and has to be deleted via the Customer Portal.",
+			state.ID.ValueString(),
+			state.Name.ValueString(),
+		),
+	)
 }
 
 func NewImageResource() resource.Resource {
@@ -2,10 +2,16 @@ package publiccloud
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
@@ -28,7 +34,107 @@ type imageModelDataSource struct {
 }
 
 type imagesDataSourceModel struct {
-	Images []imageModelDataSource `tfsdk:"images"`
+	NameRegex   types.String           `tfsdk:"name_regex"`
+	Flavour     types.String           `tfsdk:"flavour"`
+	Custom      types.Bool             `tfsdk:"custom"`
+	Region      types.String           `tfsdk:"region"`
+	StorageType types.String           `tfsdk:"storage_type"`
+	MarketApp   types.String           `tfsdk:"market_app"`
+	MostRecent  types.Bool             `tfsdk:"most_recent"`
+	Images      []imageModelDataSource `tfsdk:"images"`
+}
+
+// imageFilter only matches images that have all set values, which are also
+// passed on to the API except for name_regex, so matching them locally again
+// catches images the API did not filter out.
+type imageFilter struct {
+	name        types.String
+	nameRegex   *regexp.Regexp
+	flavour     types.String
+	custom      types.Bool
+	region      types.String
+	storageType types.String
+	marketApp   types.String
+}
+
+func (f imageFilter) applyToRequest(
+	request publiccloud.ApiGetImageListRequest,
+) publiccloud.ApiGetImageListRequest {
+	if !f.name.IsNull() {
+		request = request.Name(f.name.ValueString())
+	}
+	if !f.flavour.IsNull() {
+		request = request.Flavour(publiccloud.Flavour(f.flavour.ValueString()))
+	}
+	if !f.custom.IsNull() {
+		if f.custom.ValueBool() {
+			request = request.Custom(true)
+		} else {
+			request = request.Standard(true)
+		}
+	}
+	if !f.region.IsNull() {
+		request = request.Region(f.region.ValueString())
+	}
+	if !f.storageType.IsNull() {
+		request = request.StorageType(publiccloud.StorageType(f.storageType.ValueString()))
+	}
+	if !f.marketApp.IsNull() {
+		request = request.MarketAppId(publiccloud.MarketAppId(f.marketApp.ValueString()))
+	}
+
+	return request
+}
+
+func (f imageFilter) matches(image publiccloud.ImageDetails) bool {
+	if !f.name.IsNull() && image.GetName() != f.name.ValueString() {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(image.GetName()) {
+		return false
+	}
+	if !f.flavour.IsNull() && string(image.GetFlavour()) != f.flavour.ValueString() {
+		return false
+	}
+	if !f.custom.IsNull() && image.GetCustom() != f.custom.ValueBool() {
+		return false
+	}
+	if !f.region.IsNull() && string(image.GetRegion()) != f.region.ValueString() {
+		return false
+	}
+	if !f.storageType.IsNull() && !slices.Contains(
+		image.GetStorageTypes(),
+		publiccloud.StorageType(f.storageType.ValueString()),
+	) {
+		return false
+	}
+	if !f.marketApp.IsNull() && !slices.Contains(
+		image.GetMarketApps(),
+		publiccloud.MarketAppId(f.marketApp.ValueString()),
+	) {
+		return false
+	}
+
+	return true
+}
+
+// compileNameRegex returns nil if nameRegex is not set.
+func compileNameRegex(nameRegex types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if nameRegex.IsNull() {
+		return nil
+	}
+
+	compiledNameRegex, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute name_regex is not a valid regular expression: %s", err),
+		)
+		return nil
+	}
+
+	return compiledNameRegex
 }
 
 type imageDetailsList []publiccloud.ImageDetails
@@ -43,6 +149,33 @@ func (i imageDetailsList) findById(id string) *publiccloud.ImageDetails {
 	return nil
 }
 
+func (i imageDetailsList) filter(filter imageFilter) imageDetailsList {
+	var images imageDetailsList
+	for _, image := range i {
+		if filter.matches(image) {
+			images = append(images, image)
+		}
+	}
+
+	return images
+}
+
+// findMostRecent returns the most recently created image. Images without a
+// creation date, such as the standard images, are considered to be the
+// oldest.
+func (i imageDetailsList) findMostRecent() *publiccloud.ImageDetails {
+	var mostRecent *publiccloud.ImageDetails
+
+	for _, image := range i {
+		if mostRecent == nil ||
+			image.GetCreatedAt().After(mostRecent.GetCreatedAt()) {
+			mostRecent = &image
+		}
+	}
+
+	return mostRecent
+}
+
 func adaptImageDetailsToImageDataSource(imageDetails publiccloud.ImageDetails) imageModelDataSource {
 	var marketApps []string
 	var storageTypes []string
@@ -67,15 +200,18 @@ func adaptImageDetailsToImageDataSource(imageDetails publiccloud.ImageDetails) i
 	}
 }
 
+// getAllImages returns the images that match the filters supported by the
+// API. The remaining filters have to be matched with imageDetailsList.filter.
 func getAllImages(
 	ctx context.Context,
 	api publiccloud.PubliccloudAPI,
+	filter imageFilter,
 	diags *diag.Diagnostics,
 ) imageDetailsList {
 	var images imageDetailsList
 	var offset *int32
 
-	request := api.GetImageList(ctx)
+	request := filter.applyToRequest(api.GetImageList(ctx))
 
 	for {
 		result, httpResponse, err := request.Execute()
@@ -104,6 +240,31 @@ func getAllImages(
 	return images
 }
 
+// imageFilterSchemaAttributes returns the filters that are shared by the
+// image data sources.
+func imageFilterSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return images whose name matches this regular expression.",
+		},
+		"storage_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return images that support this storage type. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedStorageTypeEnumValues),
+			Validators: []validator.String{
+				stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedStorageTypeEnumValues)...),
+			},
+		},
+		"market_app": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return images that support this market app. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedMarketAppIdEnumValues),
+			Validators: []validator.String{
+				stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedMarketAppIdEnumValues)...),
+			},
+		},
+	}
+}
+
 func imageSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
@@ -147,30 +308,80 @@ func (i *imagesDataSource) Schema(
 	_ datasource.SchemaRequest,
 	response *datasource.SchemaResponse,
 ) {
+	attributes := imageFilterSchemaAttributes()
+	attributes["flavour"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Only return images with this flavour. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedFlavourEnumValues),
+		Validators: []validator.String{
+			stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedFlavourEnumValues)...),
+		},
+	}
+	attributes["custom"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Only return custom images if true or standard images if false.",
+	}
+	attributes["region"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Only return images in this region. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+		Validators: []validator.String{
+			stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+		},
+	}
+	attributes["most_recent"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Only return the most recently created image that matches the filters.",
+	}
+	attributes["images"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: imageSchemaAttributes(),
+		},
+	}
+
 	response.Schema = schema.Schema{
 		Description: utils.BetaDescription,
-		Attributes: map[string]schema.Attribute{
-			"images": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: imageSchemaAttributes(),
-				},
-			},
-		},
+		Attributes:  attributes,
 	}
 }
 
 func (i *imagesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	request datasource.ReadRequest,
 	response *datasource.ReadResponse,
 ) {
-	images := getAllImages(ctx, i.PubliccloudAPI, &response.Diagnostics)
+	var config imagesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	var state imagesDataSourceModel
+	nameRegex := compileNameRegex(config.NameRegex, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	filter := imageFilter{
+		name:        basetypes.NewStringNull(),
+		nameRegex:   nameRegex,
+		flavour:     config.Flavour,
+		custom:      config.Custom,
+		region:      config.Region,
+		storageType: config.StorageType,
+		marketApp:   config.MarketApp,
+	}
+	images := getAllImages(ctx, i.PubliccloudAPI, filter, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	images = images.filter(filter)
+
+	if config.MostRecent.ValueBool() && len(images) > 0 {
+		images = imageDetailsList{*images.findMostRecent()}
+	}
+
+	state := config
+	state.Images = nil
 	for _, imageDetails := range images {
 		state.Images = append(
 			state.Images,
@@ -1,7 +1,9 @@
 package publiccloud
 
 import (
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
@@ -55,3 +57,88 @@ func Test_adaptImageDetailsToImageDataSource(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func Test_imageFilter_matches(t *testing.T) {
+	region := publiccloud.REGIONNAME_EU_WEST_3
+	image := publiccloud.ImageDetails{
+		Name:         "Ubuntu 24.04 LTS (x86_64)",
+		Flavour:      publiccloud.FLAVOUR_UBUNTU,
+		Custom:       false,
+		Region:       *publiccloud.NewNullableRegionName(&region),
+		StorageTypes: []publiccloud.StorageType{publiccloud.STORAGETYPE_CENTRAL},
+		MarketApps:   []publiccloud.MarketAppId{publiccloud.MARKETAPPID_CPANEL_30},
+	}
+	emptyFilter := imageFilter{
+		name:        basetypes.NewStringNull(),
+		flavour:     basetypes.NewStringNull(),
+		custom:      basetypes.NewBoolNull(),
+		region:      basetypes.NewStringNull(),
+		storageType: basetypes.NewStringNull(),
+		marketApp:   basetypes.NewStringNull(),
+	}
+
+	t.Run("empty filter matches", func(t *testing.T) {
+		assert.True(t, emptyFilter.matches(image))
+	})
+
+	t.Run("all filters match", func(t *testing.T) {
+		filter := imageFilter{
+			name:        basetypes.NewStringValue("Ubuntu 24.04 LTS (x86_64)"),
+			nameRegex:   regexp.MustCompile(`^Ubuntu 24`),
+			flavour:     basetypes.NewStringValue("ubuntu"),
+			custom:      basetypes.NewBoolValue(false),
+			region:      basetypes.NewStringValue("eu-west-3"),
+			storageType: basetypes.NewStringValue("CENTRAL"),
+			marketApp:   basetypes.NewStringValue("CPANEL_30"),
+		}
+
+		assert.True(t, filter.matches(image))
+	})
+
+	t.Run("name_regex does not match", func(t *testing.T) {
+		filter := emptyFilter
+		filter.nameRegex = regexp.MustCompile(`^Ubuntu 22`)
+
+		assert.False(t, filter.matches(image))
+	})
+
+	t.Run("custom does not match", func(t *testing.T) {
+		filter := emptyFilter
+		filter.custom = basetypes.NewBoolValue(true)
+
+		assert.False(t, filter.matches(image))
+	})
+
+	t.Run("region does not match", func(t *testing.T) {
+		filter := emptyFilter
+		filter.region = basetypes.NewStringValue("eu-west-2")
+
+		assert.False(t, filter.matches(image))
+	})
+
+	t.Run("storage_type does not match", func(t *testing.T) {
+		filter := emptyFilter
+		filter.storageType = basetypes.NewStringValue("LOCAL")
+
+		assert.False(t, filter.matches(image))
+	})
+
+	t.Run("market_app does not match", func(t *testing.T) {
+		filter := emptyFilter
+		filter.marketApp = basetypes.NewStringValue("PLESK_18")
+
+		assert.False(t, filter.matches(image))
+	})
+}
+
+func Test_imageDetailsList_findMostRecent(t *testing.T) {
+	createdAt := time.Date(2024, 7, 5, 10, 54, 27, 0, time.UTC)
+	list := imageDetailsList{
+		{Id: "standard"},
+		{Id: "custom", CreatedAt: *publiccloud.NewNullableTime(&createdAt)},
+	}
+
+	got := list.findMostRecent()
+
+	assert.Equal(t, "custom", got.GetId())
+}
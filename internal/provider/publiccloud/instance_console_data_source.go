@@ -0,0 +1,109 @@
+package publiccloud
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &instanceConsoleDataSource{}
+)
+
+type instanceConsoleDataSourceModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	URL        types.String `tfsdk:"url"`
+	Token      types.String `tfsdk:"token"`
+}
+
+func adaptGetConsoleAccessResultToInstanceConsoleDataSource(
+	instanceID string,
+	consoleAccess publiccloud.GetConsoleAccessResult,
+) instanceConsoleDataSourceModel {
+	instanceConsole := instanceConsoleDataSourceModel{
+		InstanceID: basetypes.NewStringValue(instanceID),
+		URL:        basetypes.NewStringPointerValue(consoleAccess.Url),
+		Token:      basetypes.NewStringNull(),
+	}
+
+	// The token is only returned as part of the console URL.
+	consoleURL, err := url.Parse(consoleAccess.GetUrl())
+	if err == nil && consoleURL.Query().Has("token") {
+		instanceConsole.Token = basetypes.NewStringValue(
+			consoleURL.Query().Get("token"),
+		)
+	}
+
+	return instanceConsole
+}
+
+type instanceConsoleDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (i *instanceConsoleDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the instance.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL to the instance's VNC console",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The console access token, as included in `url`",
+			},
+		},
+	}
+}
+
+func (i *instanceConsoleDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config instanceConsoleDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	consoleAccess, httpResponse, err := i.PubliccloudAPI.GetConsoleAccess(
+		ctx,
+		config.InstanceID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptGetConsoleAccessResultToInstanceConsoleDataSource(
+		config.InstanceID.ValueString(),
+		*consoleAccess,
+	)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func NewInstanceConsoleDataSource() datasource.DataSource {
+	return &instanceConsoleDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_instance_console",
+		},
+	}
+}
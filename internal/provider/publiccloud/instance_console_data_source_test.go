@@ -0,0 +1,44 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptGetConsoleAccessResultToInstanceConsoleDataSource(t *testing.T) {
+	t.Run("token is extracted from the url", func(t *testing.T) {
+		consoleURL := "https://example.com/vnc.html?autoconnect=true&token=abc%2Bdef"
+
+		got := adaptGetConsoleAccessResultToInstanceConsoleDataSource(
+			"instanceId",
+			publiccloud.GetConsoleAccessResult{Url: &consoleURL},
+		)
+
+		assert.Equal(t, "instanceId", got.InstanceID.ValueString())
+		assert.Equal(t, consoleURL, got.URL.ValueString())
+		assert.Equal(t, "abc+def", got.Token.ValueString())
+	})
+
+	t.Run("token is null if the url has no token", func(t *testing.T) {
+		consoleURL := "https://example.com/vnc.html"
+
+		got := adaptGetConsoleAccessResultToInstanceConsoleDataSource(
+			"instanceId",
+			publiccloud.GetConsoleAccessResult{Url: &consoleURL},
+		)
+
+		assert.True(t, got.Token.IsNull())
+	})
+
+	t.Run("url & token are null if the url is not set", func(t *testing.T) {
+		got := adaptGetConsoleAccessResultToInstanceConsoleDataSource(
+			"instanceId",
+			publiccloud.GetConsoleAccessResult{},
+		)
+
+		assert.True(t, got.URL.IsNull())
+		assert.True(t, got.Token.IsNull())
+	})
+}
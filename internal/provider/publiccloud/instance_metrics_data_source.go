@@ -0,0 +1,242 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &instanceMetricsDataSource{}
+)
+
+func adaptMetricsPropertiesToMetricsSeries(
+	name string,
+	metricsProperties *publiccloud.MetricsProperties,
+) utils.MetricsSeriesDataSourceModel {
+	var values []utils.MetricsValueDataSourceModel
+	var unit *string
+
+	if metricsProperties != nil {
+		for _, metricsValue := range metricsProperties.GetValues() {
+			values = append(values, utils.MetricsValueDataSourceModel{
+				Timestamp: utils.AdaptNullableTimeToRFC3339StringValue(metricsValue.Timestamp),
+				Value:     basetypes.NewFloat64Value(float64(metricsValue.GetValue())),
+			})
+		}
+		if sdkUnit, ok := metricsProperties.GetUnitOk(); ok {
+			unit = (*string)(sdkUnit)
+		}
+	}
+
+	return utils.NewMetricsSeries(name, basetypes.NewStringPointerValue(unit), values)
+}
+
+func adaptTrafficMetricToMetricsSeries(
+	name string,
+	trafficMetric *publiccloud.TrafficMetric,
+) utils.MetricsSeriesDataSourceModel {
+	var values []utils.MetricsValueDataSourceModel
+	var unit *string
+
+	if trafficMetric != nil {
+		for _, trafficMetricValue := range trafficMetric.GetValues() {
+			values = append(values, utils.MetricsValueDataSourceModel{
+				Timestamp: utils.AdaptNullableTimeToRFC3339StringValue(trafficMetricValue.Timestamp),
+				Value:     basetypes.NewFloat64Value(float64(trafficMetricValue.GetValue())),
+			})
+		}
+		unit = trafficMetric.Unit
+	}
+
+	return utils.NewMetricsSeries(name, basetypes.NewStringPointerValue(unit), values)
+}
+
+const metricsSeriesDescription = "One entry per series returned for the metric, e.g. *DOWN_PUBLIC* & *UP_PUBLIC* for *datatraffic*"
+
+// validateMetricsGranularity checks that the granularity is supported by the
+// chosen metric, as every metric endpoint supports a different set.
+func validateMetricsGranularity(
+	metric string,
+	granularity types.String,
+	allowedGranularities map[string][]string,
+	diags *diag.Diagnostics,
+) {
+	if granularity.IsNull() {
+		return
+	}
+
+	if !slices.Contains(allowedGranularities[metric], granularity.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("granularity"),
+			"Invalid Attribute Value Match",
+			fmt.Sprintf(
+				"Attribute granularity value must be one of %q for metric %q, got: %q",
+				allowedGranularities[metric],
+				metric,
+				granularity.ValueString(),
+			),
+		)
+	}
+}
+
+const (
+	instanceMetricCPU         = "cpu"
+	instanceMetricDataTraffic = "datatraffic"
+)
+
+var instanceMetricsGranularities = map[string][]string{
+	instanceMetricCPU:         utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedCpuMetricsGranularityEnumValues),
+	instanceMetricDataTraffic: utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedDataTrafficMetricsGranularityEnumValues),
+}
+
+type instanceMetricsDataSourceModel struct {
+	InstanceID  types.String                         `tfsdk:"instance_id"`
+	Metric      types.String                         `tfsdk:"metric"`
+	From        types.String                         `tfsdk:"from"`
+	To          types.String                         `tfsdk:"to"`
+	Granularity types.String                         `tfsdk:"granularity"`
+	Series      []utils.MetricsSeriesDataSourceModel `tfsdk:"series"`
+}
+
+type instanceMetricsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (i *instanceMetricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the instance.",
+			},
+			"metric": schema.StringAttribute{
+				Required:    true,
+				Description: "Valid options are " + utils.StringTypeArrayToMarkdown([]string{instanceMetricCPU, instanceMetricDataTraffic}) + "\nThe API does not expose the network bandwidth of instances, use *datatraffic* instead.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(instanceMetricCPU, instanceMetricDataTraffic),
+				},
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the interval, e.g. `2025-01-28`",
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the interval, e.g. `2025-01-29`",
+			},
+			"granularity": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Valid options for *%s* are %s\nValid options for *%s* are %s",
+					instanceMetricCPU,
+					utils.StringTypeArrayToMarkdown(instanceMetricsGranularities[instanceMetricCPU]),
+					instanceMetricDataTraffic,
+					utils.StringTypeArrayToMarkdown(instanceMetricsGranularities[instanceMetricDataTraffic]),
+				),
+			},
+			"series": utils.MetricsSeriesSchemaAttribute(metricsSeriesDescription),
+		},
+	}
+}
+
+func (i *instanceMetricsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config instanceMetricsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateMetricsGranularity(
+		config.Metric.ValueString(),
+		config.Granularity,
+		instanceMetricsGranularities,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state := config
+	instanceID := config.InstanceID.ValueString()
+
+	switch config.Metric.ValueString() {
+	case instanceMetricCPU:
+		metricsRequest := i.PubliccloudAPI.GetCpuMetrics(ctx, instanceID)
+		if !config.From.IsNull() {
+			metricsRequest = metricsRequest.From(config.From.ValueString())
+		}
+		if !config.To.IsNull() {
+			metricsRequest = metricsRequest.To(config.To.ValueString())
+		}
+		if !config.Granularity.IsNull() {
+			metricsRequest = metricsRequest.Granularity(
+				publiccloud.CpuMetricsGranularity(config.Granularity.ValueString()),
+			)
+		}
+
+		result, httpResponse, err := metricsRequest.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		metrics := result.GetMetrics()
+		state.Series = []utils.MetricsSeriesDataSourceModel{
+			adaptMetricsPropertiesToMetricsSeries("CPU", metrics.CpuMetrics),
+		}
+	case instanceMetricDataTraffic:
+		metricsRequest := i.PubliccloudAPI.GetInstanceDataTrafficMetrics(ctx, instanceID)
+		if !config.From.IsNull() {
+			metricsRequest = metricsRequest.From(config.From.ValueString())
+		}
+		if !config.To.IsNull() {
+			metricsRequest = metricsRequest.To(config.To.ValueString())
+		}
+		if !config.Granularity.IsNull() {
+			metricsRequest = metricsRequest.Granularity(config.Granularity.ValueString())
+		}
+
+		result, httpResponse, err := metricsRequest.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		metrics := result.GetMetrics()
+		state.Series = []utils.MetricsSeriesDataSourceModel{
+			adaptTrafficMetricToMetricsSeries("DOWN_PUBLIC", metrics.DownPublic),
+			adaptTrafficMetricToMetricsSeries("UP_PUBLIC", metrics.UpPublic),
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewInstanceMetricsDataSource() datasource.DataSource {
+	return &instanceMetricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_instance_metrics",
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptMetricsPropertiesToMetricsSeries(t *testing.T) {
+	timestamp := time.Date(2025, 1, 28, 10, 0, 0, 0, time.UTC)
+	value := float32(12.5)
+	unit := publiccloud.METRICSUNIT_PERCENT
+
+	got := adaptMetricsPropertiesToMetricsSeries(
+		"CPU",
+		&publiccloud.MetricsProperties{
+			Values: []publiccloud.MetricsValues{
+				{Value: &value, Timestamp: &timestamp},
+			},
+			Unit: &unit,
+		},
+	)
+
+	assert.Equal(t, "CPU", got.Name.ValueString())
+	assert.Equal(t, "%", got.Unit.ValueString())
+	assert.Len(t, got.Values, 1)
+	assert.Equal(t, "2025-01-28T10:00:00Z", got.Values[0].Timestamp.ValueString())
+	assert.Equal(t, 12.5, got.Values[0].Value.ValueFloat64())
+	assert.Equal(t, 12.5, got.Avg.ValueFloat64())
+}
+
+func Test_adaptTrafficMetricToMetricsSeries(t *testing.T) {
+	t.Run("values are set", func(t *testing.T) {
+		timestamp := time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
+		value := int32(1024)
+		unit := "B"
+
+		got := adaptTrafficMetricToMetricsSeries(
+			"UP_PUBLIC",
+			&publiccloud.TrafficMetric{
+				Values: []publiccloud.TrafficMetricValue{
+					{Value: &value, Timestamp: &timestamp},
+				},
+				Unit: &unit,
+			},
+		)
+
+		assert.Equal(t, "B", got.Unit.ValueString())
+		assert.Equal(t, float64(1024), got.Max.ValueFloat64())
+	})
+
+	t.Run("missing metric returns an empty series", func(t *testing.T) {
+		got := adaptTrafficMetricToMetricsSeries("UP_PUBLIC", nil)
+
+		assert.Empty(t, got.Values)
+		assert.True(t, got.Avg.IsNull())
+	})
+}
+
+func Test_validateMetricsGranularity(t *testing.T) {
+	t.Run("supported granularity passes", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateMetricsGranularity(
+			instanceMetricCPU,
+			basetypes.NewStringValue("5m"),
+			instanceMetricsGranularities,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("unsupported granularity returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateMetricsGranularity(
+			instanceMetricDataTraffic,
+			basetypes.NewStringValue("5m"),
+			instanceMetricsGranularities,
+			&diags,
+		)
+
+		assert.True(t, diags.HasError())
+	})
+}
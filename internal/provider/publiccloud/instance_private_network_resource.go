@@ -0,0 +1,280 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &instancePrivateNetworkResource{}
+	_ resource.ResourceWithImportState = &instancePrivateNetworkResource{}
+)
+
+type instancePrivateNetworkResourceModel struct {
+	InstanceID       types.String `tfsdk:"instance_id"`
+	PrivateNetworkID types.String `tfsdk:"private_network_id"`
+	Status           types.String `tfsdk:"status"`
+	Subnet           types.String `tfsdk:"subnet"`
+	IP               types.String `tfsdk:"ip"`
+}
+
+func adaptInstanceDetailsToInstancePrivateNetworkResource(
+	instanceDetails publiccloud.InstanceDetails,
+) instancePrivateNetworkResourceModel {
+	instancePrivateNetwork := instancePrivateNetworkResourceModel{
+		InstanceID:       basetypes.NewStringValue(instanceDetails.GetId()),
+		PrivateNetworkID: basetypes.NewStringNull(),
+		Status:           basetypes.NewStringNull(),
+		Subnet:           basetypes.NewStringNull(),
+		IP:               basetypes.NewStringNull(),
+	}
+
+	privateNetwork, _ := instanceDetails.GetPrivateNetworkOk()
+	if privateNetwork != nil {
+		instancePrivateNetwork.PrivateNetworkID = basetypes.NewStringValue(privateNetwork.GetPrivateNetworkId())
+		instancePrivateNetwork.Status = basetypes.NewStringValue(privateNetwork.GetStatus())
+		instancePrivateNetwork.Subnet = basetypes.NewStringValue(privateNetwork.GetSubnet())
+	}
+
+	internalIP := findInternalIP(instanceDetails.GetIps())
+	if internalIP != nil {
+		instancePrivateNetwork.IP = basetypes.NewStringValue(internalIP.GetIp())
+	}
+
+	return instancePrivateNetwork
+}
+
+// findInternalIP returns the instance's private network IP if it has one.
+func findInternalIP(ips []publiccloud.IpDetails) *publiccloud.IpDetails {
+	for _, ip := range ips {
+		if ip.GetNetworkType() == publiccloud.NETWORKTYPE_INTERNAL {
+			return &ip
+		}
+	}
+
+	return nil
+}
+
+// waitForInstancePrivateNetwork polls the instance until it is attached to
+// or detached from the private network. An instance only counts as attached
+// once its private IP has been assigned.
+func waitForInstancePrivateNetwork(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	instanceID string,
+	attached bool,
+	diags *diag.Diagnostics,
+) *publiccloud.InstanceDetails {
+	pollInstance := func() (*publiccloud.InstanceDetails, error) {
+		instanceDetails, httpResponse, err := api.
+			GetInstance(ctx, instanceID).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil, backoff.Permanent(err)
+		}
+
+		privateNetwork, _ := instanceDetails.GetPrivateNetworkOk()
+		internalIP := findInternalIP(instanceDetails.GetIps())
+
+		if attached && (privateNetwork == nil || internalIP == nil) {
+			return nil, fmt.Errorf(
+				"instance %s is not attached to the private network yet",
+				instanceID,
+			)
+		}
+		if !attached && privateNetwork != nil {
+			return nil, fmt.Errorf(
+				"instance %s is not detached from the private network yet",
+				instanceID,
+			)
+		}
+
+		return instanceDetails, nil
+	}
+
+	instanceDetails, err := backoff.Retry(
+		ctx,
+		pollInstance,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+
+	return instanceDetails
+}
+
+type instancePrivateNetworkResource struct {
+	utils.ResourceAPI
+}
+
+func (i *instancePrivateNetworkResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(
+		ctx,
+		path.Root("instance_id"),
+		request,
+		response,
+	)
+}
+
+func (i *instancePrivateNetworkResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: "Creating this resource adds the instance to the private network, deleting it removes the instance from the private network. Instances with snapshots cannot be added to the private network.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "Instance's ID. **WARNING!** Changing instance_id will cause the current instance to be removed from the private network.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"private_network_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"subnet": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ip": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The instance's private network IP",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (i *instancePrivateNetworkResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan instancePrivateNetworkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := i.PubliccloudAPI.AddToPrivateNetwork(
+		ctx,
+		plan.InstanceID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	instanceDetails := waitForInstancePrivateNetwork(
+		ctx,
+		i.PubliccloudAPI,
+		plan.InstanceID.ValueString(),
+		true,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state := adaptInstanceDetailsToInstancePrivateNetworkResource(*instanceDetails)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (i *instancePrivateNetworkResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var currentState instancePrivateNetworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	instanceDetails, httpResponse, err := i.PubliccloudAPI.GetInstance(
+		ctx,
+		currentState.InstanceID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	// The instance has been removed from the private network outside of Terraform.
+	privateNetwork, _ := instanceDetails.GetPrivateNetworkOk()
+	if privateNetwork == nil {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	state := adaptInstanceDetailsToInstancePrivateNetworkResource(*instanceDetails)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// Update does nothing as changing instance_id replaces the resource.
+func (i *instancePrivateNetworkResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (i *instancePrivateNetworkResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var currentState instancePrivateNetworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := i.PubliccloudAPI.RemoveFromPrivateNetwork(
+		ctx,
+		currentState.InstanceID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	waitForInstancePrivateNetwork(
+		ctx,
+		i.PubliccloudAPI,
+		currentState.InstanceID.ValueString(),
+		false,
+		&response.Diagnostics,
+	)
+}
+
+func NewInstancePrivateNetworkResource() resource.Resource {
+	return &instancePrivateNetworkResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "public_cloud_instance_private_network",
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptInstanceDetailsToInstancePrivateNetworkResource(t *testing.T) {
+	t.Run("private network fields are set", func(t *testing.T) {
+		privateNetwork := publiccloud.PrivateNetwork{
+			PrivateNetworkId: "privateNetworkId",
+			Status:           "CONNECTED",
+			Subnet:           "10.0.0.0/24",
+		}
+		instanceDetails := publiccloud.InstanceDetails{
+			Id:             "instanceId",
+			PrivateNetwork: *publiccloud.NewNullablePrivateNetwork(&privateNetwork),
+			Ips: []publiccloud.IpDetails{
+				{Ip: "1.2.3.4", NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+				{Ip: "10.0.0.1", NetworkType: publiccloud.NETWORKTYPE_INTERNAL},
+			},
+		}
+
+		got := adaptInstanceDetailsToInstancePrivateNetworkResource(instanceDetails)
+
+		assert.Equal(t, "instanceId", got.InstanceID.ValueString())
+		assert.Equal(t, "privateNetworkId", got.PrivateNetworkID.ValueString())
+		assert.Equal(t, "CONNECTED", got.Status.ValueString())
+		assert.Equal(t, "10.0.0.0/24", got.Subnet.ValueString())
+		assert.Equal(t, "10.0.0.1", got.IP.ValueString())
+	})
+
+	t.Run("fields are null without a private network", func(t *testing.T) {
+		instanceDetails := publiccloud.InstanceDetails{
+			Id: "instanceId",
+			Ips: []publiccloud.IpDetails{
+				{Ip: "1.2.3.4", NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+			},
+		}
+
+		got := adaptInstanceDetailsToInstancePrivateNetworkResource(instanceDetails)
+
+		assert.True(t, got.PrivateNetworkID.IsNull())
+		assert.True(t, got.Status.IsNull())
+		assert.True(t, got.Subnet.IsNull())
+		assert.True(t, got.IP.IsNull())
+	})
+}
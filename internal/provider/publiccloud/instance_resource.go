@@ -3,7 +3,9 @@ package publiccloud
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -58,6 +61,37 @@ func adaptContractToContractResource(contract publiccloud.Contract) contractReso
 	}
 }
 
+// adaptImageToImageResource adapts the image of an instance, which contains
+// less details than the image itself.
+func adaptImageToImageResource(image publiccloud.Image) imageResourceModel {
+	emptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
+
+	return imageResourceModel{
+		ID:           basetypes.NewStringValue(image.GetId()),
+		Name:         basetypes.NewStringValue(image.GetName()),
+		Custom:       basetypes.NewBoolValue(image.GetCustom()),
+		Flavour:      basetypes.NewStringValue(string(image.GetFlavour())),
+		MarketApps:   emptyList,
+		StorageTypes: emptyList,
+	}
+}
+
+type instanceIPResourceModel struct {
+	ReverseLookup types.String `tfsdk:"reverse_lookup"`
+	InstanceID    types.String `tfsdk:"instance_id"`
+	IP            types.String `tfsdk:"ip"`
+	NetworkType   types.String `tfsdk:"network_type"`
+}
+
+func adaptIpDetailsToInstanceIPResource(ipDetails publiccloud.IpDetails) instanceIPResourceModel {
+	reverseLookup, _ := ipDetails.GetReverseLookupOk()
+	return instanceIPResourceModel{
+		ReverseLookup: basetypes.NewStringPointerValue(reverseLookup),
+		IP:            basetypes.NewStringValue(ipDetails.GetIp()),
+		NetworkType:   basetypes.NewStringValue(string(ipDetails.GetNetworkType())),
+	}
+}
+
 type instanceResourceModel struct {
 	ID                  types.String `tfsdk:"id"`
 	Region              types.String `tfsdk:"region"`
@@ -71,6 +105,8 @@ type instanceResourceModel struct {
 	IPs                 types.List   `tfsdk:"ips"`
 	Contract            types.Object `tfsdk:"contract"`
 	MarketAppID         types.String `tfsdk:"market_app_id"`
+	// AllowContractTermination only exists in Terraform.
+	AllowContractTermination types.Bool `tfsdk:"allow_contract_termination"`
 }
 
 func adaptInstanceDetailsToInstanceResource(
@@ -91,30 +127,9 @@ func adaptInstanceDetailsToInstanceResource(
 
 	image := utils.AdaptSdkModelToResourceObject(
 		instanceDetails.Image,
-		map[string]attr.Type{
-			"id":            types.StringType,
-			"instance_id":   types.StringType,
-			"name":          types.StringType,
-			"custom":        types.BoolType,
-			"state":         types.StringType,
-			"market_apps":   types.ListType{ElemType: types.StringType},
-			"storage_types": types.ListType{ElemType: types.StringType},
-			"flavour":       types.StringType,
-			"region":        types.StringType,
-		},
+		imageResourceModel{}.attributeTypes(),
 		ctx,
-		func(image publiccloud.Image) imageResourceModel {
-			emptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
-
-			return imageResourceModel{
-				ID:           basetypes.NewStringValue(image.GetId()),
-				Name:         basetypes.NewStringValue(image.GetName()),
-				Custom:       basetypes.NewBoolValue(image.GetCustom()),
-				Flavour:      basetypes.NewStringValue(string(image.GetFlavour())),
-				MarketApps:   emptyList,
-				StorageTypes: emptyList,
-			}
-		},
+		adaptImageToImageResource,
 		diags,
 	)
 	if diags.HasError() {
@@ -128,9 +143,10 @@ func adaptInstanceDetailsToInstanceResource(
 			"reverse_lookup": types.StringType,
 			"instance_id":    types.StringType,
 			"ip":             types.StringType,
+			"network_type":   types.StringType,
 		},
 		ctx,
-		adaptIpDetailsToIPResource,
+		adaptIpDetailsToInstanceIPResource,
 		diags,
 	)
 	if diags.HasError() {
@@ -174,6 +190,76 @@ func adaptInstanceDetailsToInstanceResource(
 	return &instance
 }
 
+// cancelTerminationPlanModifier plans a scheduled termination to be
+// cancelled, so that importing an instance that is pending termination and
+// applying keeps it running.
+type cancelTerminationPlanModifier struct{}
+
+func (c cancelTerminationPlanModifier) Description(_ context.Context) string {
+	return "Cancels a scheduled termination of the instance."
+}
+
+func (c cancelTerminationPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return c.Description(ctx)
+}
+
+func (c cancelTerminationPlanModifier) PlanModifyString(
+	_ context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	// Nothing to cancel on create & destroy.
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.StateValue.ValueString() == string(publiccloud.CONTRACTSTATE_DELETE_SCHEDULED) {
+		resp.PlanValue = basetypes.NewStringValue(string(publiccloud.CONTRACTSTATE_ACTIVE))
+	}
+}
+
+// waitForInstanceContractState polls the instance until its contract
+// reaches the desired state.
+func waitForInstanceContractState(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	instanceID string,
+	desiredState publiccloud.ContractState,
+	diags *diag.Diagnostics,
+) *publiccloud.InstanceDetails {
+	pollInstance := func() (*publiccloud.InstanceDetails, error) {
+		instanceDetails, httpResponse, err := api.
+			GetInstance(ctx, instanceID).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil, backoff.Permanent(err)
+		}
+
+		if instanceDetails.Contract.GetState() != desiredState {
+			return nil, fmt.Errorf(
+				"contract of instance %s has state %s, waiting for %s",
+				instanceID,
+				instanceDetails.Contract.GetState(),
+				desiredState,
+			)
+		}
+
+		return instanceDetails, nil
+	}
+
+	instanceDetails, err := backoff.Retry(
+		ctx,
+		pollInstance,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+
+	return instanceDetails
+}
+
 func NewInstanceResource() resource.Resource {
 	return &instanceResource{
 		ResourceAPI: utils.ResourceAPI{
@@ -250,6 +336,7 @@ func (i *instanceResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.AllowContractTermination = plan.AllowContractTermination
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -265,13 +352,63 @@ func (i *instanceResource) Delete(
 		return
 	}
 
+	contract := contractResourceModel{}
+	resp.Diagnostics.Append(
+		state.Contract.As(ctx, &contract, basetypes.ObjectAsOptions{})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Instances with a monthly contract keep running until the contract ends.
+	monthly := contract.Type.ValueString() == string(publiccloud.CONTRACTTYPE_MONTHLY)
+	if monthly && !state.AllowContractTermination.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Instance has a MONTHLY contract",
+			fmt.Sprintf(
+				"Instance %s is only terminated once its contract ends. Set allow_contract_termination to true to schedule the termination.",
+				state.ID.ValueString(),
+			),
+		)
+		return
+	}
+
 	httpResponse, err := i.PubliccloudAPI.TerminateInstance(
 		ctx,
 		state.ID.ValueString(),
 	).Execute()
 	if err != nil {
 		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+		return
+	}
+
+	if !monthly {
+		return
+	}
+
+	instanceDetails := waitForInstanceContractState(
+		ctx,
+		i.PubliccloudAPI,
+		state.ID.ValueString(),
+		publiccloud.CONTRACTSTATE_DELETE_SCHEDULED,
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endsAt := "the end of its contract"
+	if instanceDetails.Contract.EndsAt.Get() != nil {
+		endsAt = instanceDetails.Contract.EndsAt.Get().Format(time.RFC3339)
 	}
+	resp.Diagnostics.AddWarning(
+		"Instance termination is scheduled",
+		fmt.Sprintf(
+			"Instance %s will be terminated at %s and is billed until then. To cancel the termination, import the instance again and apply.",
+			state.ID.ValueString(),
+			endsAt,
+		),
+	)
 }
 
 func (i *instanceResource) ImportState(
@@ -314,6 +451,11 @@ func (i *instanceResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.AllowContractTermination = state.AllowContractTermination
+	// Imported instances do not have a value yet.
+	if newState.AllowContractTermination.IsNull() {
+		newState.AllowContractTermination = basetypes.NewBoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
@@ -329,6 +471,41 @@ func (i *instanceResource) Update(
 		return
 	}
 
+	var currentState instanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentContract := contractResourceModel{}
+	resp.Diagnostics.Append(
+		currentState.Contract.As(ctx, &currentContract, basetypes.ObjectAsOptions{})...,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if currentContract.State.ValueString() == string(publiccloud.CONTRACTSTATE_DELETE_SCHEDULED) {
+		httpResponse, err := i.PubliccloudAPI.
+			CancelInstanceTermination(ctx, plan.ID.ValueString()).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+			return
+		}
+
+		waitForInstanceContractState(
+			ctx,
+			i.PubliccloudAPI,
+			plan.ID.ValueString(),
+			publiccloud.CONTRACTSTATE_ACTIVE,
+			&resp.Diagnostics,
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	opts := publiccloud.NewUpdateInstanceOpts()
 	opts.Reference = utils.AdaptStringPointerValueToNullableString(plan.Reference)
 	opts.RootDiskSize = utils.AdaptInt32PointerValueToNullableInt32(plan.RootDiskSize)
@@ -372,6 +549,7 @@ func (i *instanceResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.AllowContractTermination = plan.AllowContractTermination
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -519,6 +697,10 @@ func (i *instanceResource) Schema(
 						"reverse_lookup": schema.StringAttribute{
 							Computed: true,
 						},
+						"network_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "*PUBLIC* or *INTERNAL*. Private network IPs are *INTERNAL*",
+						},
 					},
 				},
 			},
@@ -548,7 +730,11 @@ func (i *instanceResource) Schema(
 					},
 					"ends_at": schema.StringAttribute{Computed: true},
 					"state": schema.StringAttribute{
-						Computed: true,
+						Computed:    true,
+						Description: "*DELETE_SCHEDULED* once the termination of a *MONTHLY* contract is scheduled. Applying an instance that is scheduled to be deleted cancels the termination",
+						PlanModifiers: []planmodifier.String{
+							cancelTerminationPlanModifier{},
+						},
 					},
 				},
 			},
@@ -560,6 +746,12 @@ func (i *instanceResource) Schema(
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"allow_contract_termination": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Instances with a *MONTHLY* contract are only terminated once the contract ends. Destroying them fails unless this is set to `true` and applied beforehand, in which case the termination is scheduled and its date is reported as a warning.",
+			},
 		},
 	}
 }
@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,7 +61,8 @@ func Test_adaptInstanceDetailsToInstanceResource(t *testing.T) {
 		},
 		Ips: []publiccloud.IpDetails{
 			{
-				Ip: "127.0.0.1",
+				Ip:          "127.0.0.1",
+				NetworkType: publiccloud.NETWORKTYPE_INTERNAL,
 			},
 		},
 		Iso: *publiccloud.NewNullableIso(&isoSdk),
@@ -91,12 +95,61 @@ func Test_adaptInstanceDetailsToInstanceResource(t *testing.T) {
 	got.Contract.As(context.TODO(), &contract, basetypes.ObjectAsOptions{})
 	assert.Equal(t, "MONTHLY", contract.Type.ValueString())
 
-	var ips []ipResourceModel
+	var ips []instanceIPResourceModel
 	got.IPs.ElementsAs(context.TODO(), &ips, false)
 	assert.Len(t, ips, 1)
 	assert.Equal(t, "127.0.0.1", ips[0].IP.ValueString())
+	assert.Equal(t, "INTERNAL", ips[0].NetworkType.ValueString())
 
 	iso := isoResourceModel{}
 	got.ISO.As(context.TODO(), &iso, basetypes.ObjectAsOptions{})
 	assert.Equal(t, "isoId", iso.ID.ValueString())
 }
+
+func Test_cancelTerminationPlanModifier_PlanModifyString(t *testing.T) {
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	t.Run("scheduled termination is planned to be cancelled", func(t *testing.T) {
+		resp := planmodifier.StringResponse{PlanValue: basetypes.NewStringUnknown()}
+		cancelTerminationPlanModifier{}.PlanModifyString(
+			context.TODO(),
+			planmodifier.StringRequest{
+				Plan:       plan,
+				StateValue: basetypes.NewStringValue("DELETE_SCHEDULED"),
+			},
+			&resp,
+		)
+
+		assert.Equal(t, "ACTIVE", resp.PlanValue.ValueString())
+	})
+
+	t.Run("active contract is not modified", func(t *testing.T) {
+		resp := planmodifier.StringResponse{PlanValue: basetypes.NewStringUnknown()}
+		cancelTerminationPlanModifier{}.PlanModifyString(
+			context.TODO(),
+			planmodifier.StringRequest{
+				Plan:       plan,
+				StateValue: basetypes.NewStringValue("ACTIVE"),
+			},
+			&resp,
+		)
+
+		assert.True(t, resp.PlanValue.IsUnknown())
+	})
+
+	t.Run("nothing is modified on create", func(t *testing.T) {
+		resp := planmodifier.StringResponse{PlanValue: basetypes.NewStringUnknown()}
+		cancelTerminationPlanModifier{}.PlanModifyString(
+			context.TODO(),
+			planmodifier.StringRequest{
+				Plan:       plan,
+				StateValue: basetypes.NewStringNull(),
+			},
+			&resp,
+		)
+
+		assert.True(t, resp.PlanValue.IsUnknown())
+	})
+}
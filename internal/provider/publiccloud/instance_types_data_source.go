@@ -0,0 +1,172 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &instanceTypesDataSource{}
+)
+
+type instanceTypeDataSourceModel struct {
+	Name         types.String  `tfsdk:"name"`
+	CPU          types.Int32   `tfsdk:"cpu"`
+	Memory       types.Float64 `tfsdk:"memory"`
+	MemoryUnit   types.String  `tfsdk:"memory_unit"`
+	StorageTypes []string      `tfsdk:"storage_types"`
+	Currency     types.String  `tfsdk:"currency"`
+	HourlyPrice  types.String  `tfsdk:"hourly_price"`
+	MonthlyPrice types.String  `tfsdk:"monthly_price"`
+}
+
+func adaptInstanceTypeToInstanceTypeDataSource(instanceType publiccloud.InstanceType) instanceTypeDataSourceModel {
+	var storageTypes []string
+	for _, storageType := range instanceType.GetStorageTypes() {
+		storageTypes = append(storageTypes, string(storageType))
+	}
+
+	resources := instanceType.GetResources()
+	prices := instanceType.GetPrices()
+
+	return instanceTypeDataSourceModel{
+		Name:         basetypes.NewStringValue(string(instanceType.GetName())),
+		CPU:          basetypes.NewInt32Value(resources.Cpu.GetValue()),
+		Memory:       basetypes.NewFloat64Value(float64(resources.Memory.GetValue())),
+		MemoryUnit:   basetypes.NewStringValue(resources.Memory.GetUnit()),
+		StorageTypes: storageTypes,
+		Currency:     basetypes.NewStringValue(prices.GetCurrency()),
+		HourlyPrice:  basetypes.NewStringValue(prices.Compute.GetHourlyPrice()),
+		MonthlyPrice: basetypes.NewStringValue(prices.Compute.GetMonthlyPrice()),
+	}
+}
+
+type instanceTypesDataSourceModel struct {
+	Region        types.String                  `tfsdk:"region"`
+	InstanceTypes []instanceTypeDataSourceModel `tfsdk:"instance_types"`
+}
+
+type instanceTypesDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (i *instanceTypesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Required:    true,
+				Description: "Only instance types available in this region are returned. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+				},
+			},
+			"instance_types": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"cpu": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of vCPUs",
+						},
+						"memory": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Amount of memory, expressed in `memory_unit`",
+						},
+						"memory_unit": schema.StringAttribute{
+							Computed: true,
+						},
+						"storage_types": schema.ListAttribute{
+							Computed:    true,
+							Description: "The supported storage types for the instance type",
+							ElementType: types.StringType,
+						},
+						"currency": schema.StringAttribute{
+							Computed: true,
+						},
+						"hourly_price": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hourly compute price, expressed in `currency`",
+						},
+						"monthly_price": schema.StringAttribute{
+							Computed:    true,
+							Description: "Monthly compute price, expressed in `currency`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *instanceTypesDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config instanceTypesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var instanceTypes []publiccloud.InstanceType
+	var offset *int32
+
+	instanceTypesRequest := i.PubliccloudAPI.GetInstanceTypeList(ctx).
+		Region(publiccloud.RegionName(config.Region.ValueString()))
+	for {
+		result, httpResponse, err := instanceTypesRequest.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		instanceTypes = append(instanceTypes, result.GetInstanceTypes()...)
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			break
+		}
+
+		instanceTypesRequest = instanceTypesRequest.Offset(*offset)
+	}
+
+	state := instanceTypesDataSourceModel{Region: config.Region}
+	for _, instanceType := range instanceTypes {
+		state.InstanceTypes = append(
+			state.InstanceTypes,
+			adaptInstanceTypeToInstanceTypeDataSource(instanceType),
+		)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewInstanceTypesDataSource() datasource.DataSource {
+	return &instanceTypesDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_instance_types",
+		},
+	}
+}
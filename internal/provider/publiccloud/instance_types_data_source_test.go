@@ -0,0 +1,40 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptInstanceTypeToInstanceTypeDataSource(t *testing.T) {
+	instanceType := publiccloud.InstanceType{
+		Name: publiccloud.TYPENAME_M3_LARGE,
+		Resources: publiccloud.Resources{
+			Cpu:    publiccloud.Cpu{Value: 2, Unit: "vCPU"},
+			Memory: publiccloud.Memory{Value: 7.5, Unit: "GiB"},
+		},
+		StorageTypes: []publiccloud.StorageType{
+			publiccloud.STORAGETYPE_LOCAL,
+			publiccloud.STORAGETYPE_CENTRAL,
+		},
+		Prices: publiccloud.Prices{
+			Currency: "EUR",
+			Compute: publiccloud.Price{
+				HourlyPrice:  "0.0411",
+				MonthlyPrice: "30.00",
+			},
+		},
+	}
+
+	got := adaptInstanceTypeToInstanceTypeDataSource(instanceType)
+
+	assert.Equal(t, "lsw.m3.large", got.Name.ValueString())
+	assert.Equal(t, int32(2), got.CPU.ValueInt32())
+	assert.Equal(t, 7.5, got.Memory.ValueFloat64())
+	assert.Equal(t, "GiB", got.MemoryUnit.ValueString())
+	assert.Equal(t, []string{"LOCAL", "CENTRAL"}, got.StorageTypes)
+	assert.Equal(t, "EUR", got.Currency.ValueString())
+	assert.Equal(t, "0.0411", got.HourlyPrice.ValueString())
+	assert.Equal(t, "30.00", got.MonthlyPrice.ValueString())
+}
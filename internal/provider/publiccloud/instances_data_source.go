@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -60,11 +63,104 @@ type instanceDataSourceModel struct {
 }
 
 type ipDataSourceModel struct {
-	IP types.String `tfsdk:"ip"`
+	IP          types.String `tfsdk:"ip"`
+	NetworkType types.String `tfsdk:"network_type"`
 }
 
 type instancesDataSourceModel struct {
-	Instances []instanceDataSourceModel `tfsdk:"instances"`
+	Region         types.String              `tfsdk:"region"`
+	State          types.String              `tfsdk:"state"`
+	Type           types.String              `tfsdk:"type"`
+	Reference      types.String              `tfsdk:"reference"`
+	ReferenceRegex types.String              `tfsdk:"reference_regex"`
+	ImageID        types.String              `tfsdk:"image_id"`
+	ContractType   types.String              `tfsdk:"contract_type"`
+	IP             types.String              `tfsdk:"ip"`
+	Details        types.Bool                `tfsdk:"details"`
+	Instances      []instanceDataSourceModel `tfsdk:"instances"`
+}
+
+// adaptInstanceToInstanceDetails converts a list item to InstanceDetails so
+// that instances can be adapted without calling GetInstance. Fields that are
+// only returned by GetInstance, such as iso, are left empty.
+func adaptInstanceToInstanceDetails(instance publiccloud.Instance) publiccloud.InstanceDetails {
+	var ips []publiccloud.IpDetails
+	for _, ip := range instance.GetIps() {
+		ips = append(ips, publiccloud.IpDetails{
+			Ip:            ip.GetIp(),
+			PrefixLength:  ip.GetPrefixLength(),
+			Version:       ip.GetVersion(),
+			NullRouted:    ip.GetNullRouted(),
+			MainIp:        ip.GetMainIp(),
+			NetworkType:   ip.GetNetworkType(),
+			ReverseLookup: ip.ReverseLookup,
+		})
+	}
+
+	return publiccloud.InstanceDetails{
+		Id:                  instance.GetId(),
+		Type:                instance.GetType(),
+		Resources:           instance.GetResources(),
+		Region:              instance.GetRegion(),
+		Reference:           instance.Reference,
+		StartedAt:           instance.StartedAt,
+		MarketAppId:         instance.MarketAppId,
+		State:               instance.GetState(),
+		RootDiskSize:        instance.GetRootDiskSize(),
+		RootDiskStorageType: instance.GetRootDiskStorageType(),
+		Contract:            instance.GetContract(),
+		Image:               instance.GetImage(),
+		Ips:                 ips,
+	}
+}
+
+func adaptInstanceDetailsToInstanceDataSource(
+	instanceDetails publiccloud.InstanceDetails,
+	images imageDetailsList,
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) *instanceDataSourceModel {
+	var ips []ipDataSourceModel
+	for _, ip := range instanceDetails.Ips {
+		ips = append(
+			ips,
+			ipDataSourceModel{
+				IP:          basetypes.NewStringValue(ip.GetIp()),
+				NetworkType: basetypes.NewStringValue(string(ip.GetNetworkType())),
+			},
+		)
+	}
+
+	instance := instanceDataSourceModel{
+		Contract:            adaptContractToContractDataSource(instanceDetails.GetContract()),
+		ID:                  basetypes.NewStringValue(instanceDetails.GetId()),
+		IPs:                 ips,
+		MarketAppID:         basetypes.NewStringPointerValue(instanceDetails.MarketAppId.Get()),
+		RootDiskSize:        basetypes.NewInt32Value(instanceDetails.GetRootDiskSize()),
+		RootDiskStorageType: basetypes.NewStringValue(string(instanceDetails.GetRootDiskStorageType())),
+		Region:              basetypes.NewStringValue(string(instanceDetails.GetRegion())),
+		Reference:           basetypes.NewStringPointerValue(instanceDetails.Reference.Get()),
+		State:               basetypes.NewStringValue(string(instanceDetails.GetState())),
+		Type:                basetypes.NewStringValue(string(instanceDetails.GetType())),
+	}
+	imageDetails := images.findById(instanceDetails.Image.Id)
+	if imageDetails == nil {
+		utils.GeneralError(
+			diags,
+			ctx,
+			fmt.Errorf("imageDetails %s not found", instanceDetails.Image.Id),
+		)
+		return nil
+	}
+	instance.Image = adaptImageDetailsToImageDataSource(*imageDetails)
+
+	sdkIso, _ := instanceDetails.GetIsoOk()
+	if sdkIso != nil {
+		iso := adaptIsoToISODataSource(*sdkIso)
+		instance.ISO = &iso
+	}
+
+	return &instance
 }
 
 func NewInstancesDataSource() datasource.DataSource {
@@ -81,14 +177,55 @@ type instancesDataSource struct {
 
 func (d *instancesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
+	var config instancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var referenceRegex *regexp.Regexp
+	if !config.ReferenceRegex.IsNull() {
+		var err error
+		referenceRegex, err = regexp.Compile(config.ReferenceRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reference_regex"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute reference_regex is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
 	var instances []publiccloud.Instance
 	var offset *int32
 
-	// Get instances
+	// Get instances, filters that the API supports are pushed down
 	request := d.PubliccloudAPI.GetInstanceList(ctx)
+	if !config.Region.IsNull() {
+		request = request.Region(publiccloud.RegionName(config.Region.ValueString()))
+	}
+	if !config.State.IsNull() {
+		request = request.State(publiccloud.State(config.State.ValueString()))
+	}
+	if !config.Type.IsNull() {
+		request = request.Type_(publiccloud.TypeName(config.Type.ValueString()))
+	}
+	if !config.Reference.IsNull() {
+		request = request.Reference(config.Reference.ValueString())
+	}
+	if !config.ImageID.IsNull() {
+		request = request.ImageId(config.ImageID.ValueString())
+	}
+	if !config.ContractType.IsNull() {
+		request = request.ContractType(publiccloud.ContractType(config.ContractType.ValueString()))
+	}
+	if !config.IP.IsNull() {
+		request = request.Ip(config.IP.ValueString())
+	}
 	for {
 		result, httpResponse, err := request.Execute()
 		if err != nil {
@@ -96,7 +233,12 @@ func (d *instancesDataSource) Read(
 			return
 		}
 
-		instances = append(instances, result.Instances...)
+		for _, instance := range result.Instances {
+			if referenceRegex != nil && !referenceRegex.MatchString(instance.GetReference()) {
+				continue
+			}
+			instances = append(instances, instance)
+		}
 
 		metadata := result.GetMetadata()
 		offset = utils.NewOffset(
@@ -111,87 +253,68 @@ func (d *instancesDataSource) Read(
 	}
 
 	//Get images once
-	images := getAllImages(ctx, d.PubliccloudAPI, &resp.Diagnostics)
+	images := getAllImages(ctx, d.PubliccloudAPI, imageFilter{}, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Get instanceDetails for each instance
 	var instanceDetailsList []publiccloud.InstanceDetails
-	resultChan := make(chan publiccloud.InstanceDetails)
-	errorChan := make(chan instanceDetailsErr)
-	for _, instance := range instances {
-		go func(id string) {
-			instanceDetails, httpResponse, err := d.PubliccloudAPI.GetInstance(
-				ctx,
-				id,
-			).Execute()
-			if err != nil {
-				errorChan <- instanceDetailsErr{
-					err:          err,
-					httpResponse: httpResponse,
+	if config.Details.IsNull() || config.Details.ValueBool() {
+		// Get instanceDetails for each instance
+		resultChan := make(chan publiccloud.InstanceDetails)
+		errorChan := make(chan instanceDetailsErr)
+		for _, instance := range instances {
+			go func(id string) {
+				instanceDetails, httpResponse, err := d.PubliccloudAPI.GetInstance(
+					ctx,
+					id,
+				).Execute()
+				if err != nil {
+					errorChan <- instanceDetailsErr{
+						err:          err,
+						httpResponse: httpResponse,
+					}
+					return
 				}
+				resultChan <- *instanceDetails
+			}(instance.Id)
+		}
+		for i := 0; i < len(instances); i++ {
+			select {
+			case err := <-errorChan:
+				utils.SdkError(ctx, &resp.Diagnostics, err.err, err.httpResponse)
 				return
+			case res := <-resultChan:
+				instanceDetailsList = append(instanceDetailsList, res)
 			}
-			resultChan <- *instanceDetails
-		}(instance.Id)
-	}
-	for i := 0; i < len(instances); i++ {
-		select {
-		case err := <-errorChan:
-			utils.SdkError(ctx, &resp.Diagnostics, err.err, err.httpResponse)
-			return
-		case res := <-resultChan:
-			instanceDetailsList = append(instanceDetailsList, res)
+		}
+	} else {
+		for _, instance := range instances {
+			instanceDetailsList = append(
+				instanceDetailsList,
+				adaptInstanceToInstanceDetails(instance),
+			)
 		}
 	}
 
-	var state instancesDataSourceModel
+	state := config
+	state.Instances = nil
 
 	sort.Slice(instanceDetailsList, func(i, j int) bool {
 		return instanceDetailsList[i].Id < instanceDetailsList[j].Id
 	})
 	for _, instanceDetails := range instanceDetailsList {
-		var ips []ipDataSourceModel
-		for _, ip := range instanceDetails.Ips {
-			ips = append(
-				ips,
-				ipDataSourceModel{
-					IP: basetypes.NewStringValue(ip.GetIp()),
-				},
-			)
-		}
-
-		instance := instanceDataSourceModel{
-			Contract:            adaptContractToContractDataSource(instanceDetails.GetContract()),
-			ID:                  basetypes.NewStringValue(instanceDetails.GetId()),
-			IPs:                 ips,
-			MarketAppID:         basetypes.NewStringPointerValue(instanceDetails.MarketAppId.Get()),
-			RootDiskSize:        basetypes.NewInt32Value(instanceDetails.GetRootDiskSize()),
-			RootDiskStorageType: basetypes.NewStringValue(string(instanceDetails.GetRootDiskStorageType())),
-			Region:              basetypes.NewStringValue(string(instanceDetails.GetRegion())),
-			Reference:           basetypes.NewStringPointerValue(instanceDetails.Reference.Get()),
-			State:               basetypes.NewStringValue(string(instanceDetails.GetState())),
-			Type:                basetypes.NewStringValue(string(instanceDetails.GetType())),
-		}
-		imageDetails := images.findById(instanceDetails.Image.Id)
-		if imageDetails == nil {
-			utils.GeneralError(
-				&resp.Diagnostics,
-				ctx,
-				fmt.Errorf("imageDetails %s not found", instance.Image.ID),
-			)
+		instance := adaptInstanceDetailsToInstanceDataSource(
+			instanceDetails,
+			images,
+			ctx,
+			&resp.Diagnostics,
+		)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		instance.Image = adaptImageDetailsToImageDataSource(*imageDetails)
 
-		sdkIso, _ := instanceDetails.GetIsoOk()
-		if sdkIso != nil {
-			iso := adaptIsoToISODataSource(*sdkIso)
-			instance.ISO = &iso
-		}
-
-		state.Instances = append(state.Instances, instance)
+		state.Instances = append(state.Instances, *instance)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -214,6 +337,54 @@ func (d *instancesDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: utils.BetaDescription,
 		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances in this region. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances with this state. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedStateEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedStateEnumValues)...),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances of this instance type.",
+			},
+			"reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances with exactly this reference.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("reference_regex")),
+				},
+			},
+			"reference_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances whose reference matches this regular expression.",
+			},
+			"image_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances that use this image.",
+			},
+			"contract_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return instances with this contract type. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedContractTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedContractTypeEnumValues)...),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the instance that has this IP.",
+			},
+			"details": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Defaults to `true`. When `false` the instance details are not retrieved for every instance, which is considerably faster for large accounts. `iso` is not set in that case.",
+			},
 			"instances": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -292,6 +463,10 @@ func (d *instancesDataSource) Schema(
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"ip": schema.StringAttribute{Computed: true},
+									"network_type": schema.StringAttribute{
+										Computed:    true,
+										Description: "*PUBLIC* or *INTERNAL*. Private network IPs are *INTERNAL*",
+									},
 								},
 							},
 						},
@@ -1,9 +1,11 @@
 package publiccloud
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 	"github.com/stretchr/testify/assert"
@@ -33,3 +35,75 @@ func Test_adaptContractToContractDataSource(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func Test_adaptInstanceToInstanceDetails(t *testing.T) {
+	reference := "reference"
+	instance := publiccloud.Instance{
+		Id:        "id",
+		Type:      publiccloud.TYPENAME_M3_LARGE,
+		Region:    publiccloud.REGIONNAME_EU_WEST_3,
+		Reference: *publiccloud.NewNullableString(&reference),
+		State:     publiccloud.STATE_RUNNING,
+		Image:     publiccloud.Image{Id: "UBUNTU_22_04_64BIT"},
+		Ips: []publiccloud.Ip{
+			{Ip: "10.0.0.1", NetworkType: publiccloud.NETWORKTYPE_INTERNAL},
+		},
+	}
+
+	got := adaptInstanceToInstanceDetails(instance)
+
+	assert.Equal(t, "id", got.Id)
+	assert.Equal(t, publiccloud.TYPENAME_M3_LARGE, got.Type)
+	assert.Equal(t, publiccloud.REGIONNAME_EU_WEST_3, got.Region)
+	assert.Equal(t, "reference", got.GetReference())
+	assert.Equal(t, publiccloud.STATE_RUNNING, got.State)
+	assert.Equal(t, "UBUNTU_22_04_64BIT", got.Image.Id)
+	assert.Len(t, got.Ips, 1)
+	assert.Equal(t, "10.0.0.1", got.Ips[0].Ip)
+	assert.Equal(t, publiccloud.NETWORKTYPE_INTERNAL, got.Ips[0].NetworkType)
+}
+
+func Test_adaptInstanceDetailsToInstanceDataSource(t *testing.T) {
+	t.Run("instance is adapted", func(t *testing.T) {
+		instanceDetails := publiccloud.InstanceDetails{
+			Id:    "id",
+			Image: publiccloud.Image{Id: "UBUNTU_22_04_64BIT"},
+			Ips: []publiccloud.IpDetails{
+				{Ip: "1.2.3.4", NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+			},
+		}
+		images := imageDetailsList{{Id: "UBUNTU_22_04_64BIT", Name: "Ubuntu"}}
+		diags := diag.Diagnostics{}
+
+		got := adaptInstanceDetailsToInstanceDataSource(
+			instanceDetails,
+			images,
+			context.TODO(),
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "id", got.ID.ValueString())
+		assert.Equal(t, "Ubuntu", got.Image.Name.ValueString())
+		assert.Equal(t, "1.2.3.4", got.IPs[0].IP.ValueString())
+		assert.Equal(t, "PUBLIC", got.IPs[0].NetworkType.ValueString())
+		assert.Nil(t, got.ISO)
+	})
+
+	t.Run("unknown image returns an error", func(t *testing.T) {
+		instanceDetails := publiccloud.InstanceDetails{
+			Image: publiccloud.Image{Id: "tralala"},
+		}
+		diags := diag.Diagnostics{}
+
+		got := adaptInstanceDetailsToInstanceDataSource(
+			instanceDetails,
+			imageDetailsList{},
+			context.TODO(),
+			&diags,
+		)
+
+		assert.Nil(t, got)
+		assert.True(t, diags.HasError())
+	})
+}
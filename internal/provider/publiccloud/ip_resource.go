@@ -4,9 +4,12 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
@@ -19,9 +22,11 @@ var (
 )
 
 type ipResourceModel struct {
-	ReverseLookup types.String `tfsdk:"reverse_lookup"`
-	InstanceID    types.String `tfsdk:"instance_id"`
-	IP            types.String `tfsdk:"ip"`
+	ReverseLookup    types.String `tfsdk:"reverse_lookup"`
+	InstanceID       types.String `tfsdk:"instance_id"`
+	IP               types.String `tfsdk:"ip"`
+	NullRouted       types.Bool   `tfsdk:"null_routed"`
+	NullRouteComment types.String `tfsdk:"null_route_comment"`
 }
 
 type ipResource struct {
@@ -31,11 +36,56 @@ type ipResource struct {
 func adaptIpDetailsToIPResource(ipDetails publiccloud.IpDetails) ipResourceModel {
 	reverseLookup, _ := ipDetails.GetReverseLookupOk()
 	return ipResourceModel{
-		ReverseLookup: basetypes.NewStringPointerValue(reverseLookup),
-		IP:            basetypes.NewStringValue(ipDetails.GetIp()),
+		ReverseLookup:    basetypes.NewStringPointerValue(reverseLookup),
+		IP:               basetypes.NewStringValue(ipDetails.GetIp()),
+		NullRouted:       basetypes.NewBoolValue(ipDetails.GetNullRouted()),
+		NullRouteComment: basetypes.NewStringNull(),
 	}
 }
 
+// updateNullRoute null routes the IP or removes the null route if
+// nullRouted differs from the current state of the IP.
+func (i *ipResource) updateNullRoute(
+	ctx context.Context,
+	plan ipResourceModel,
+	ipDetails publiccloud.IpDetails,
+	diags *diag.Diagnostics,
+) *publiccloud.IpDetails {
+	if plan.NullRouted.IsUnknown() ||
+		plan.NullRouted.ValueBool() == ipDetails.GetNullRouted() {
+		return &ipDetails
+	}
+
+	if plan.NullRouted.ValueBool() {
+		opts := publiccloud.NewNullRouteIPOpts()
+		opts.Comment = plan.NullRouteComment.ValueStringPointer()
+
+		nullRoutedIP, httpResponse, err := i.PubliccloudAPI.NullRouteInstanceIP(
+			ctx,
+			plan.InstanceID.ValueString(),
+			plan.IP.ValueString(),
+		).NullRouteIPOpts(*opts).Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil
+		}
+
+		return nullRoutedIP
+	}
+
+	unNullRoutedIP, httpResponse, err := i.PubliccloudAPI.RemoveInstanceIPNullRoute(
+		ctx,
+		plan.InstanceID.ValueString(),
+		plan.IP.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
+	}
+
+	return unNullRoutedIP
+}
+
 func (i *ipResource) ImportState(
 	ctx context.Context,
 	request resource.ImportStateRequest,
@@ -80,6 +130,18 @@ func (i *ipResource) Schema(
 			"ip": schema.StringAttribute{
 				Required: true,
 			},
+			"null_routed": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Setting this to true null routes the IP, setting it to false removes the null route",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"null_route_comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "The reason why the IP is null routed. Only sent when the IP gets null routed",
+			},
 		},
 	}
 
@@ -120,6 +182,8 @@ func (i *ipResource) Read(
 
 	newState := adaptIpDetailsToIPResource(*ip)
 	newState.InstanceID = state.InstanceID
+	// null_route_comment has to be set manually as it isn't returned from the API
+	newState.NullRouteComment = state.NullRouteComment
 
 	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 }
@@ -146,8 +210,14 @@ func (i *ipResource) Update(
 		return
 	}
 
+	ipDetails = i.updateNullRoute(ctx, plan, *ipDetails, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	state := adaptIpDetailsToIPResource(*ipDetails)
 	state.InstanceID = plan.InstanceID
+	state.NullRouteComment = plan.NullRouteComment
 
 	response.Diagnostics.Append(
 		response.State.Set(ctx, state)...,
@@ -17,8 +17,10 @@ func Test_adaptIpDetailsToIPResource(t *testing.T) {
 		}
 
 		want := ipResourceModel{
-			IP:            basetypes.NewStringValue("127.0.0.1"),
-			ReverseLookup: basetypes.NewStringPointerValue(&reverseLookup),
+			IP:               basetypes.NewStringValue("127.0.0.1"),
+			ReverseLookup:    basetypes.NewStringPointerValue(&reverseLookup),
+			NullRouted:       basetypes.NewBoolValue(false),
+			NullRouteComment: basetypes.NewStringNull(),
 		}
 		got := adaptIpDetailsToIPResource(sdkIpDetails)
 
@@ -32,11 +34,24 @@ func Test_adaptIpDetailsToIPResource(t *testing.T) {
 		}
 
 		want := ipResourceModel{
-			IP:            basetypes.NewStringValue("127.0.0.1"),
-			ReverseLookup: basetypes.NewStringPointerValue(nil),
+			IP:               basetypes.NewStringValue("127.0.0.1"),
+			ReverseLookup:    basetypes.NewStringPointerValue(nil),
+			NullRouted:       basetypes.NewBoolValue(false),
+			NullRouteComment: basetypes.NewStringNull(),
 		}
 		got := adaptIpDetailsToIPResource(sdkIpDetails)
 
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("nullRouted is set", func(t *testing.T) {
+		sdkIpDetails := publiccloud.IpDetails{
+			Ip:         "127.0.0.1",
+			NullRouted: true,
+		}
+
+		got := adaptIpDetailsToIPResource(sdkIpDetails)
+
+		assert.True(t, got.NullRouted.ValueBool())
+	})
 }
@@ -2,7 +2,15 @@ package publiccloud
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -21,8 +29,10 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &loadBalancerListenerResource{}
-	_ resource.ResourceWithImportState = &loadBalancerListenerResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerListenerResource{}
+	_ resource.ResourceWithImportState    = &loadBalancerListenerResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerListenerResource{}
+	_ resource.ResourceWithModifyPlan     = &loadBalancerListenerResource{}
 )
 
 type loadBalancerListenerDefaultRuleResourceModel struct {
@@ -46,9 +56,101 @@ func adaptLoadBalancerListenerRuleToLoadBalancerListenerDefaultRuleResource(load
 }
 
 type loadBalancerListenerCertificateResourceModel struct {
-	PrivateKey  types.String `tfsdk:"private_key"`
-	Certificate types.String `tfsdk:"certificate"`
-	Chain       types.String `tfsdk:"chain"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+	Certificate     types.String `tfsdk:"certificate"`
+	Chain           types.String `tfsdk:"chain"`
+	PrivateKeyPath  types.String `tfsdk:"private_key_path"`
+	CertificatePath types.String `tfsdk:"certificate_path"`
+	ChainPath       types.String `tfsdk:"chain_path"`
+	Fingerprint     types.String `tfsdk:"fingerprint"`
+	NotAfter        types.String `tfsdk:"not_after"`
+}
+
+func (l loadBalancerListenerCertificateResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"private_key":      types.StringType,
+		"certificate":      types.StringType,
+		"chain":            types.StringType,
+		"private_key_path": types.StringType,
+		"certificate_path": types.StringType,
+		"chain_path":       types.StringType,
+		"fingerprint":      types.StringType,
+		"not_after":        types.StringType,
+	}
+}
+
+// usesPaths returns true if any of the PEM encoded values is read from a file.
+func (l loadBalancerListenerCertificateResourceModel) usesPaths() bool {
+	return !l.PrivateKeyPath.IsNull() ||
+		!l.CertificatePath.IsNull() ||
+		!l.ChainPath.IsNull()
+}
+
+// isKnown returns false if any of the sources is not known yet.
+func (l loadBalancerListenerCertificateResourceModel) isKnown() bool {
+	for _, value := range []types.String{
+		l.PrivateKey,
+		l.Certificate,
+		l.Chain,
+		l.PrivateKeyPath,
+		l.CertificatePath,
+		l.ChainPath,
+	} {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolvePEM returns the certificate with the PEM encoded values read from
+// the configured files. The files are never stored in state.
+func (l loadBalancerListenerCertificateResourceModel) resolvePEM(
+	diags *diag.Diagnostics,
+) loadBalancerListenerCertificateResourceModel {
+	readFile := func(filePath types.String, attribute string) types.String {
+		content, err := os.ReadFile(filePath.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("certificate").AtName(attribute),
+				"Unable to read file",
+				err.Error(),
+			)
+			return basetypes.NewStringNull()
+		}
+
+		return basetypes.NewStringValue(string(content))
+	}
+
+	if !l.PrivateKeyPath.IsNull() {
+		l.PrivateKey = readFile(l.PrivateKeyPath, "private_key_path")
+	}
+	if !l.CertificatePath.IsNull() {
+		l.Certificate = readFile(l.CertificatePath, "certificate_path")
+	}
+	if !l.ChainPath.IsNull() {
+		l.Chain = readFile(l.ChainPath, "chain_path")
+	}
+
+	return l
+}
+
+// withComputedAttributes sets the fingerprint & expiry date of the
+// certificate. They are null if the certificate cannot be parsed.
+func (l loadBalancerListenerCertificateResourceModel) withComputedAttributes() loadBalancerListenerCertificateResourceModel {
+	l.Fingerprint = basetypes.NewStringNull()
+	l.NotAfter = basetypes.NewStringNull()
+
+	certificate, err := parseCertificatePEM(l.Certificate.ValueString())
+	if err != nil {
+		return l
+	}
+
+	l.Fingerprint = basetypes.NewStringValue(certificateFingerprint(certificate))
+	l.NotAfter = basetypes.NewStringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+
+	return l
 }
 
 func (l loadBalancerListenerCertificateResourceModel) generateSslCertificate() publiccloud.SslCertificate {
@@ -63,6 +165,199 @@ func (l loadBalancerListenerCertificateResourceModel) generateSslCertificate() p
 	return *sslCertificate
 }
 
+func adaptSslCertificateToLoadBalancerListenerCertificateResource(
+	sslCertificate publiccloud.SslCertificate,
+) loadBalancerListenerCertificateResourceModel {
+	certificate := loadBalancerListenerCertificateResourceModel{
+		PrivateKey:      basetypes.NewStringValue(sslCertificate.GetPrivateKey()),
+		Certificate:     basetypes.NewStringValue(sslCertificate.GetCertificate()),
+		Chain:           basetypes.NewStringNull(),
+		PrivateKeyPath:  basetypes.NewStringNull(),
+		CertificatePath: basetypes.NewStringNull(),
+		ChainPath:       basetypes.NewStringNull(),
+	}
+
+	chain, _ := sslCertificate.GetChainOk()
+	if chain != nil && *chain != "" {
+		certificate.Chain = basetypes.NewStringPointerValue(chain)
+	}
+
+	return certificate.withComputedAttributes()
+}
+
+// parseCertificatePEM returns the first certificate in the PEM encoded data.
+func parseCertificatePEM(data string) (*x509.Certificate, error) {
+	certificates, err := parseCertificateChainPEM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return certificates[0], nil
+}
+
+// parseCertificateChainPEM returns all certificates in the PEM encoded data.
+func parseCertificateChainPEM(data string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block of type %q", block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+
+	return certificates, nil
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint in the same format
+// as `openssl x509 -noout -fingerprint -sha256`.
+func certificateFingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+	hexParts := make([]string, len(sum))
+	for i, b := range sum {
+		hexParts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return strings.Join(hexParts, ":")
+}
+
+// validateLoadBalancerListenerCertificate checks that the PEM encoded
+// values can be used by the load balancer. Unknown values are skipped, as
+// they are validated once they are known.
+func validateLoadBalancerListenerCertificate(
+	certificate loadBalancerListenerCertificateResourceModel,
+	now time.Time,
+	diags *diag.Diagnostics,
+) {
+	certificatePath := path.Root("certificate").AtName("certificate")
+	privateKeyPath := path.Root("certificate").AtName("private_key")
+	chainPath := path.Root("certificate").AtName("chain")
+
+	if certificate.Certificate.IsNull() || certificate.Certificate.IsUnknown() {
+		return
+	}
+
+	leaf, err := parseCertificatePEM(certificate.Certificate.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			certificatePath,
+			"Invalid Certificate",
+			fmt.Sprintf("certificate cannot be parsed: %s", err),
+		)
+		return
+	}
+
+	if !certificate.PrivateKey.IsNull() && !certificate.PrivateKey.IsUnknown() {
+		_, err = tls.X509KeyPair(
+			[]byte(certificate.Certificate.ValueString()),
+			[]byte(certificate.PrivateKey.ValueString()),
+		)
+		if err != nil {
+			diags.AddAttributeError(
+				privateKeyPath,
+				"Invalid Private Key",
+				fmt.Sprintf("private_key cannot be used with certificate: %s", err),
+			)
+		}
+	}
+
+	if certificate.Chain.IsNull() ||
+		certificate.Chain.IsUnknown() ||
+		certificate.Chain.ValueString() == "" {
+		return
+	}
+
+	chain, err := parseCertificateChainPEM(certificate.Chain.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			chainPath,
+			"Invalid Certificate Chain",
+			fmt.Sprintf("chain cannot be parsed: %s", err),
+		)
+		return
+	}
+
+	// The expiry of the certificate is validated separately, so the chain is
+	// verified at a time the certificate is still valid.
+	verifyTime := now
+	if verifyTime.After(leaf.NotAfter) {
+		verifyTime = leaf.NotAfter
+	}
+
+	pool := x509.NewCertPool()
+	for _, chainCertificate := range chain {
+		pool.AddCert(chainCertificate)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: pool,
+		CurrentTime:   verifyTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		diags.AddAttributeError(
+			chainPath,
+			"Invalid Certificate Chain",
+			fmt.Sprintf("certificate cannot be verified with chain: %s", err),
+		)
+	}
+}
+
+// validateLoadBalancerListenerCertificateExpiry returns an error if an
+// expired certificate is uploaded. A certificate that is already on the load
+// balancer, i.e. with a fingerprint equal to currentFingerprint, only warns so
+// it does not block other changes or destroying the listener.
+func validateLoadBalancerListenerCertificateExpiry(
+	certificate loadBalancerListenerCertificateResourceModel,
+	currentFingerprint types.String,
+	now time.Time,
+	diags *diag.Diagnostics,
+) {
+	leaf, err := parseCertificatePEM(certificate.Certificate.ValueString())
+	if err != nil || !now.After(leaf.NotAfter) {
+		return
+	}
+
+	certificatePath := path.Root("certificate").AtName("certificate")
+	expiredAt := leaf.NotAfter.UTC().Format(time.RFC3339)
+
+	if currentFingerprint.ValueString() == certificateFingerprint(leaf) {
+		diags.AddAttributeWarning(
+			certificatePath,
+			"Expired Certificate",
+			fmt.Sprintf(
+				"certificate expired at %s and has to be replaced",
+				expiredAt,
+			),
+		)
+		return
+	}
+
+	diags.AddAttributeError(
+		certificatePath,
+		"Expired Certificate",
+		fmt.Sprintf(
+			"certificate expired at %s and cannot be uploaded to the load balancer",
+			expiredAt,
+		),
+	)
+}
+
 type loadBalancerListenerResourceModel struct {
 	ListenerID     types.String `tfsdk:"listener_id"`
 	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
@@ -139,22 +434,57 @@ func (l *loadBalancerListenerResource) Schema(
 			},
 			"certificate": schema.SingleNestedAttribute{
 				Optional:    true,
-				Description: "Required only if protocol is HTTPS",
+				Description: "Required if protocol is `HTTPS`, not allowed otherwise",
 				Attributes: map[string]schema.Attribute{
 					"private_key": schema.StringAttribute{
 						Optional:    true,
-						Description: "Client Private Key. Required only if protocol is `HTTPS`",
+						Description: "Client Private Key. Exactly one of `private_key` or `private_key_path` is required. An inline private key is stored in state, as write-only attributes are not supported yet. Use `private_key_path` to keep it out of state",
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("private_key_path"),
+							),
+						},
 					},
 					"certificate": schema.StringAttribute{
 						Optional:    true,
-						Description: "Client Certificate. Required only if protocol is `HTTPS`",
+						Description: "Client Certificate. Exactly one of `certificate` or `certificate_path` is required. An expired certificate is rejected, unless it is already on the load balancer",
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("certificate_path"),
+							),
+						},
 					},
 					"chain": schema.StringAttribute{
 						Optional:    true,
 						Description: "CA certificate. Not required, but can be added if protocol is `HTTPS`",
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("chain_path"),
+							),
+						},
+					},
+					"private_key_path": schema.StringAttribute{
+						Optional:    true,
+						Description: "Path to the file containing the client private key. Unlike `private_key`, the private key is not stored in state",
+					},
+					"certificate_path": schema.StringAttribute{
+						Optional:    true,
+						Description: "Path to the file containing the client certificate. The certificate is rotated when the file's content changes",
+					},
+					"chain_path": schema.StringAttribute{
+						Optional:    true,
+						Description: "Path to the file containing the CA certificate",
+					},
+					"fingerprint": schema.StringAttribute{
+						Computed:    true,
+						Description: "SHA-256 fingerprint of the certificate. Drift is detected by comparing it with the certificate on the load balancer",
+					},
+					"not_after": schema.StringAttribute{
+						Computed:    true,
+						Description: "Date and time when the certificate expires",
 					},
 				},
 			},
@@ -171,6 +501,266 @@ func (l *loadBalancerListenerResource) Schema(
 	}
 }
 
+func (l *loadBalancerListenerResource) ValidateConfig(
+	ctx context.Context,
+	request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse,
+) {
+	var config loadBalancerListenerResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Protocol.IsUnknown() || config.Certificate.IsUnknown() {
+		return
+	}
+
+	if config.Protocol.ValueString() != string(publiccloud.PROTOCOL_HTTPS) {
+		if !config.Certificate.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("certificate"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf(
+					"certificate is not allowed if protocol is %s",
+					config.Protocol.ValueString(),
+				),
+			)
+		}
+		return
+	}
+
+	if config.Certificate.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("certificate"),
+			"Missing Attribute Configuration",
+			"certificate is required if protocol is HTTPS",
+		)
+		return
+	}
+
+	certificate := loadBalancerListenerCertificateResourceModel{}
+	response.Diagnostics.Append(
+		config.Certificate.As(ctx, &certificate, basetypes.ObjectAsOptions{})...,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Certificates read from files are validated when planning.
+	if certificate.usesPaths() {
+		return
+	}
+
+	validateLoadBalancerListenerCertificate(
+		certificate,
+		time.Now(),
+		&response.Diagnostics,
+	)
+}
+
+// ModifyPlan plans the fingerprint of the configured certificate, so that
+// rotating a certificate file or drift on the load balancer results in an
+// update. Expired certificates are rejected unless they are unchanged.
+func (l *loadBalancerListenerResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedCertificate types.Object
+	response.Diagnostics.Append(
+		request.Plan.GetAttribute(ctx, path.Root("certificate"), &plannedCertificate)...,
+	)
+	if response.Diagnostics.HasError() ||
+		plannedCertificate.IsNull() ||
+		plannedCertificate.IsUnknown() {
+		return
+	}
+
+	certificate := loadBalancerListenerCertificateResourceModel{}
+	response.Diagnostics.Append(
+		plannedCertificate.As(ctx, &certificate, basetypes.ObjectAsOptions{})...,
+	)
+	if response.Diagnostics.HasError() || !certificate.isKnown() {
+		return
+	}
+
+	resolvedCertificate := certificate.resolvePEM(&response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if certificate.usesPaths() {
+		validateLoadBalancerListenerCertificate(
+			resolvedCertificate,
+			time.Now(),
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	currentFingerprint := basetypes.NewStringNull()
+	if !request.State.Raw.IsNull() {
+		var currentCertificate types.Object
+		response.Diagnostics.Append(request.State.GetAttribute(
+			ctx,
+			path.Root("certificate"),
+			&currentCertificate,
+		)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if !currentCertificate.IsNull() {
+			current := loadBalancerListenerCertificateResourceModel{}
+			response.Diagnostics.Append(
+				currentCertificate.As(ctx, &current, basetypes.ObjectAsOptions{})...,
+			)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			currentFingerprint = current.Fingerprint
+		}
+	}
+
+	validateLoadBalancerListenerCertificateExpiry(
+		resolvedCertificate,
+		currentFingerprint,
+		time.Now(),
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resolvedCertificate = resolvedCertificate.withComputedAttributes()
+	response.Diagnostics.Append(response.Plan.SetAttribute(
+		ctx,
+		path.Root("certificate").AtName("fingerprint"),
+		resolvedCertificate.Fingerprint,
+	)...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(
+		ctx,
+		path.Root("certificate").AtName("not_after"),
+		resolvedCertificate.NotAfter,
+	)...)
+}
+
+// validatePlannedCertificateFingerprint returns an error if the certificate
+// differs from the planned one, i.e. because a file was rotated after planning.
+func validatePlannedCertificateFingerprint(
+	plannedFingerprint types.String,
+	resolvedCertificate loadBalancerListenerCertificateResourceModel,
+	diags *diag.Diagnostics,
+) {
+	if plannedFingerprint.IsNull() || plannedFingerprint.IsUnknown() {
+		return
+	}
+
+	fingerprint := resolvedCertificate.withComputedAttributes().Fingerprint
+	if fingerprint.Equal(plannedFingerprint) {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("certificate"),
+		"Certificate Changed",
+		fmt.Sprintf(
+			"the certificate changed after planning, its fingerprint is %q instead of %q. Run terraform plan again to use the new certificate",
+			fingerprint.ValueString(),
+			plannedFingerprint.ValueString(),
+		),
+	)
+}
+
+// adaptPlannedCertificateToCertificateObject fills in the computed
+// attributes of the planned certificate. Only the configured values are
+// kept, so the content of files does not end up in state.
+func adaptPlannedCertificateToCertificateObject(
+	plannedCertificate types.Object,
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) types.Object {
+	if plannedCertificate.IsNull() {
+		return plannedCertificate
+	}
+
+	certificate := loadBalancerListenerCertificateResourceModel{}
+	diags.Append(
+		plannedCertificate.As(ctx, &certificate, basetypes.ObjectAsOptions{})...,
+	)
+	if diags.HasError() {
+		return plannedCertificate
+	}
+
+	resolvedCertificate := certificate.resolvePEM(diags)
+	if diags.HasError() {
+		return plannedCertificate
+	}
+	resolvedCertificate = resolvedCertificate.withComputedAttributes()
+	certificate.Fingerprint = resolvedCertificate.Fingerprint
+	certificate.NotAfter = resolvedCertificate.NotAfter
+
+	certificateObject, objectDiags := types.ObjectValueFrom(
+		ctx,
+		certificate.attributeTypes(),
+		certificate,
+	)
+	diags.Append(objectDiags...)
+
+	return certificateObject
+}
+
+// adaptSslCertificateToCertificateObject detects drift by fingerprint
+// instead of by the PEM encoded values, as the configured values might
+// be formatted differently or read from files. Imported listeners take
+// over the certificate returned by the API, but not the private key, as
+// it might be configured through private_key_path.
+func adaptSslCertificateToCertificateObject(
+	sslCertificate publiccloud.SslCertificate,
+	currentCertificate types.Object,
+	ctx context.Context,
+	diags *diag.Diagnostics,
+) types.Object {
+	certificate := adaptSslCertificateToLoadBalancerListenerCertificateResource(sslCertificate)
+
+	if !currentCertificate.IsNull() {
+		current := loadBalancerListenerCertificateResourceModel{}
+		diags.Append(
+			currentCertificate.As(ctx, &current, basetypes.ObjectAsOptions{})...,
+		)
+		if diags.HasError() {
+			return currentCertificate
+		}
+
+		if certificate.Fingerprint.IsNull() ||
+			certificate.Fingerprint.Equal(current.Fingerprint) {
+			return currentCertificate
+		}
+
+		current.Fingerprint = certificate.Fingerprint
+		current.NotAfter = certificate.NotAfter
+		certificate = current
+	} else {
+		certificate.PrivateKey = basetypes.NewStringNull()
+	}
+
+	certificateObject, objectDiags := types.ObjectValueFrom(
+		ctx,
+		certificate.attributeTypes(),
+		certificate,
+	)
+	diags.Append(objectDiags...)
+
+	return certificateObject
+}
+
 func (l *loadBalancerListenerResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
@@ -203,7 +793,19 @@ func (l *loadBalancerListenerResource) Create(
 			return
 		}
 
-		opts.SetCertificate(certificate.generateSslCertificate())
+		resolvedCertificate := certificate.resolvePEM(&response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		validatePlannedCertificateFingerprint(
+			certificate.Fingerprint,
+			resolvedCertificate,
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		opts.SetCertificate(resolvedCertificate.generateSslCertificate())
 	}
 
 	loadBalancerListener, httpResponse, err := l.PubliccloudAPI.CreateLoadBalancerListener(
@@ -225,7 +827,14 @@ func (l *loadBalancerListenerResource) Create(
 	}
 
 	state.LoadBalancerID = plan.LoadBalancerID
-	state.Certificate = plan.Certificate
+	state.Certificate = adaptPlannedCertificateToCertificateObject(
+		plan.Certificate,
+		ctx,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -257,33 +866,15 @@ func (l *loadBalancerListenerResource) Read(
 		Port:       basetypes.NewInt32Value(loadBalancerListenerDetails.GetPort()),
 	}
 	if len(loadBalancerListenerDetails.SslCertificates) > 0 {
-		certificate := utils.AdaptSdkModelToResourceObject(
+		newState.Certificate = adaptSslCertificateToCertificateObject(
 			loadBalancerListenerDetails.SslCertificates[0],
-			map[string]attr.Type{
-				"private_key": types.StringType,
-				"certificate": types.StringType,
-				"chain":       types.StringType,
-			},
+			state.Certificate,
 			ctx,
-			func(sslCertificate publiccloud.SslCertificate) loadBalancerListenerCertificateResourceModel {
-				listener := loadBalancerListenerCertificateResourceModel{
-					PrivateKey:  basetypes.NewStringValue(sslCertificate.GetPrivateKey()),
-					Certificate: basetypes.NewStringValue(sslCertificate.GetCertificate()),
-				}
-
-				chain, _ := sslCertificate.GetChainOk()
-				if chain != nil && *chain != "" {
-					listener.Chain = basetypes.NewStringPointerValue(chain)
-				}
-
-				return listener
-			},
 			&response.Diagnostics,
 		)
 		if response.Diagnostics.HasError() {
 			return
 		}
-		newState.Certificate = certificate
 	}
 
 	if len(loadBalancerListenerDetails.Rules) > 0 {
@@ -336,7 +927,19 @@ func (l *loadBalancerListenerResource) Update(
 			return
 		}
 
-		opts.SetCertificate(certificate.generateSslCertificate())
+		resolvedCertificate := certificate.resolvePEM(&response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		validatePlannedCertificateFingerprint(
+			certificate.Fingerprint,
+			resolvedCertificate,
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		opts.SetCertificate(resolvedCertificate.generateSslCertificate())
 	}
 
 	if !plan.DefaultRule.IsNull() {
@@ -377,7 +980,14 @@ func (l *loadBalancerListenerResource) Update(
 	}
 
 	if state.Certificate.IsNull() {
-		state.Certificate = plan.Certificate
+		state.Certificate = adaptPlannedCertificateToCertificateObject(
+			plan.Certificate,
+			ctx,
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 	state.LoadBalancerID = plan.LoadBalancerID
 
@@ -2,12 +2,23 @@ package publiccloud
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_adaptLoadBalancerListenerRuleToLoadBalancerListenerDefaultRuleResource(t *testing.T) {
@@ -155,3 +166,488 @@ func Test_loadBalancerListenerDefaultRuleResourceModel_generateLoadBalancerListe
 
 	assert.Equal(t, want, got)
 }
+
+type testCertificate struct {
+	certificate string
+	privateKey  string
+	caCert      string
+}
+
+// generateTestCertificate returns a certificate signed by a freshly
+// generated CA.
+func generateTestCertificate(t *testing.T, notAfter time.Time) testCertificate {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return testCertificate{
+		certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		privateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		caCert:      string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})),
+	}
+}
+
+func Test_loadBalancerListenerCertificateResourceModel_withComputedAttributes(t *testing.T) {
+	t.Run("fingerprint and not_after are set", func(t *testing.T) {
+		testCert := generateTestCertificate(t, time.Date(2099, 1, 2, 3, 4, 5, 0, time.UTC))
+
+		got := loadBalancerListenerCertificateResourceModel{
+			Certificate: basetypes.NewStringValue(testCert.certificate),
+		}.withComputedAttributes()
+
+		assert.Regexp(t, `^([0-9A-F]{2}:){31}[0-9A-F]{2}$`, got.Fingerprint.ValueString())
+		assert.Equal(t, "2099-01-02T03:04:05Z", got.NotAfter.ValueString())
+	})
+
+	t.Run("invalid certificate sets null values", func(t *testing.T) {
+		got := loadBalancerListenerCertificateResourceModel{
+			Certificate: basetypes.NewStringValue("certificate"),
+		}.withComputedAttributes()
+
+		assert.True(t, got.Fingerprint.IsNull())
+		assert.True(t, got.NotAfter.IsNull())
+	})
+}
+
+func Test_parseCertificateChainPEM(t *testing.T) {
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	t.Run("all certificates are returned", func(t *testing.T) {
+		got, err := parseCertificateChainPEM(testCert.certificate + testCert.caCert)
+
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.Equal(t, "Test CA", got[1].Subject.CommonName)
+	})
+
+	t.Run("other PEM blocks return an error", func(t *testing.T) {
+		_, err := parseCertificateChainPEM(testCert.privateKey)
+
+		assert.ErrorContains(t, err, "EC PRIVATE KEY")
+	})
+
+	t.Run("data without PEM blocks returns an error", func(t *testing.T) {
+		_, err := parseCertificateChainPEM("tralala")
+
+		assert.ErrorContains(t, err, "no PEM encoded certificate found")
+	})
+}
+
+func Test_validateLoadBalancerListenerCertificate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	t.Run("valid certificate passes", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringValue(testCert.certificate),
+				PrivateKey:  basetypes.NewStringValue(testCert.privateKey),
+				Chain:       basetypes.NewStringValue(testCert.caCert),
+			},
+			now,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("missing values are skipped", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringNull(),
+				PrivateKey:  basetypes.NewStringNull(),
+				Chain:       basetypes.NewStringNull(),
+			},
+			now,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("unknown values are skipped", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringUnknown(),
+				PrivateKey:  basetypes.NewStringUnknown(),
+				Chain:       basetypes.NewStringUnknown(),
+			},
+			now,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("invalid certificate returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringValue("certificate"),
+				PrivateKey:  basetypes.NewStringValue(testCert.privateKey),
+				Chain:       basetypes.NewStringNull(),
+			},
+			now,
+			&diags,
+		)
+
+		assert.Equal(t, "Invalid Certificate", diags.Errors()[0].Summary())
+	})
+
+	t.Run("chain of an expired certificate passes", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringValue(testCert.certificate),
+				PrivateKey:  basetypes.NewStringValue(testCert.privateKey),
+				Chain:       basetypes.NewStringValue(testCert.caCert),
+			},
+			time.Date(2099, 6, 1, 0, 0, 0, 0, time.UTC),
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("private_key that does not match returns an error", func(t *testing.T) {
+		otherCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringValue(testCert.certificate),
+				PrivateKey:  basetypes.NewStringValue(otherCert.privateKey),
+				Chain:       basetypes.NewStringNull(),
+			},
+			now,
+			&diags,
+		)
+
+		assert.Equal(t, "Invalid Private Key", diags.Errors()[0].Summary())
+	})
+
+	t.Run("chain that does not verify returns an error", func(t *testing.T) {
+		otherCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificate(
+			loadBalancerListenerCertificateResourceModel{
+				Certificate: basetypes.NewStringValue(testCert.certificate),
+				PrivateKey:  basetypes.NewStringValue(testCert.privateKey),
+				Chain:       basetypes.NewStringValue(otherCert.caCert),
+			},
+			now,
+			&diags,
+		)
+
+		assert.Equal(t, "Invalid Certificate Chain", diags.Errors()[0].Summary())
+	})
+}
+
+func Test_validateLoadBalancerListenerCertificateExpiry(t *testing.T) {
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+	certificate := loadBalancerListenerCertificateResourceModel{
+		Certificate: basetypes.NewStringValue(testCert.certificate),
+	}
+	fingerprint := certificate.withComputedAttributes().Fingerprint
+	expiredAt := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid certificate passes", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificateExpiry(
+			certificate,
+			basetypes.NewStringNull(),
+			time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			&diags,
+		)
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("new expired certificate returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificateExpiry(
+			certificate,
+			basetypes.NewStringNull(),
+			expiredAt,
+			&diags,
+		)
+
+		assert.Equal(t, "Expired Certificate", diags.Errors()[0].Summary())
+	})
+
+	t.Run("rotating to an expired certificate returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificateExpiry(
+			certificate,
+			basetypes.NewStringValue("AA:BB"),
+			expiredAt,
+			&diags,
+		)
+
+		assert.Equal(t, "Expired Certificate", diags.Errors()[0].Summary())
+	})
+
+	t.Run("unchanged expired certificate returns a warning", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validateLoadBalancerListenerCertificateExpiry(
+			certificate,
+			fingerprint,
+			expiredAt,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "Expired Certificate", diags.Warnings()[0].Summary())
+	})
+}
+
+func Test_adaptSslCertificateToCertificateObject(t *testing.T) {
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+	currentCertificate, _ := basetypes.NewObjectValueFrom(
+		context.TODO(),
+		loadBalancerListenerCertificateResourceModel{}.attributeTypes(),
+		loadBalancerListenerCertificateResourceModel{
+			Certificate: basetypes.NewStringValue(testCert.certificate),
+			PrivateKey:  basetypes.NewStringValue(testCert.privateKey),
+			Chain:       basetypes.NewStringNull(),
+		}.withComputedAttributes(),
+	)
+
+	t.Run("state is kept if the fingerprint matches", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := adaptSslCertificateToCertificateObject(
+			publiccloud.SslCertificate{
+				Certificate: testCert.certificate + "\n",
+				PrivateKey:  "",
+			},
+			currentCertificate,
+			context.TODO(),
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, currentCertificate, got)
+	})
+
+	t.Run("state is kept if the certificate cannot be parsed", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := adaptSslCertificateToCertificateObject(
+			publiccloud.SslCertificate{Certificate: "certificate"},
+			currentCertificate,
+			context.TODO(),
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, currentCertificate, got)
+	})
+
+	t.Run("only the fingerprint is replaced if it differs", func(t *testing.T) {
+		otherCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+		otherCertificate := loadBalancerListenerCertificateResourceModel{
+			Certificate: basetypes.NewStringValue(otherCert.certificate),
+		}.withComputedAttributes()
+		diags := diag.Diagnostics{}
+
+		got := adaptSslCertificateToCertificateObject(
+			publiccloud.SslCertificate{
+				Certificate: otherCert.certificate,
+				PrivateKey:  otherCert.privateKey,
+			},
+			currentCertificate,
+			context.TODO(),
+			&diags,
+		)
+
+		certificate := loadBalancerListenerCertificateResourceModel{}
+		got.As(context.TODO(), &certificate, basetypes.ObjectAsOptions{})
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, testCert.certificate, certificate.Certificate.ValueString())
+		assert.Equal(t, testCert.privateKey, certificate.PrivateKey.ValueString())
+		assert.Equal(t, otherCertificate.Fingerprint, certificate.Fingerprint)
+	})
+
+	t.Run("certificate is taken over on import", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := adaptSslCertificateToCertificateObject(
+			publiccloud.SslCertificate{
+				Certificate: testCert.certificate,
+				PrivateKey:  testCert.privateKey,
+			},
+			basetypes.NewObjectNull(loadBalancerListenerCertificateResourceModel{}.attributeTypes()),
+			context.TODO(),
+			&diags,
+		)
+
+		certificate := loadBalancerListenerCertificateResourceModel{}
+		got.As(context.TODO(), &certificate, basetypes.ObjectAsOptions{})
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, testCert.certificate, certificate.Certificate.ValueString())
+		assert.True(t, certificate.CertificatePath.IsNull())
+		assert.True(t, certificate.PrivateKey.IsNull())
+	})
+}
+
+func Test_validatePlannedCertificateFingerprint(t *testing.T) {
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+	certificate := loadBalancerListenerCertificateResourceModel{
+		Certificate: basetypes.NewStringValue(testCert.certificate),
+	}
+	fingerprint := certificate.withComputedAttributes().Fingerprint
+
+	t.Run("planned fingerprint passes", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validatePlannedCertificateFingerprint(fingerprint, certificate, &diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("unknown fingerprint is skipped", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validatePlannedCertificateFingerprint(
+			basetypes.NewStringUnknown(),
+			certificate,
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("changed certificate returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		validatePlannedCertificateFingerprint(
+			basetypes.NewStringValue("AA:BB"),
+			certificate,
+			&diags,
+		)
+
+		assert.Equal(t, "Certificate Changed", diags.Errors()[0].Summary())
+	})
+}
+
+func Test_adaptPlannedCertificateToCertificateObject(t *testing.T) {
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+	privateKeyPath := filepath.Join(t.TempDir(), "private_key.pem")
+	require.NoError(t, os.WriteFile(privateKeyPath, []byte(testCert.privateKey), 0o600))
+
+	plannedCertificate, _ := basetypes.NewObjectValueFrom(
+		context.TODO(),
+		loadBalancerListenerCertificateResourceModel{}.attributeTypes(),
+		loadBalancerListenerCertificateResourceModel{
+			Certificate:     basetypes.NewStringValue(testCert.certificate),
+			PrivateKey:      basetypes.NewStringNull(),
+			Chain:           basetypes.NewStringNull(),
+			CertificatePath: basetypes.NewStringNull(),
+			PrivateKeyPath:  basetypes.NewStringValue(privateKeyPath),
+			ChainPath:       basetypes.NewStringNull(),
+			Fingerprint:     basetypes.NewStringUnknown(),
+			NotAfter:        basetypes.NewStringUnknown(),
+		},
+	)
+	diags := diag.Diagnostics{}
+
+	got := adaptPlannedCertificateToCertificateObject(
+		plannedCertificate,
+		context.TODO(),
+		&diags,
+	)
+
+	certificate := loadBalancerListenerCertificateResourceModel{}
+	got.As(context.TODO(), &certificate, basetypes.ObjectAsOptions{})
+
+	assert.False(t, diags.HasError())
+	assert.True(t, certificate.PrivateKey.IsNull())
+	assert.Equal(t, privateKeyPath, certificate.PrivateKeyPath.ValueString())
+	assert.False(t, certificate.Fingerprint.IsNull())
+}
+
+func Test_loadBalancerListenerCertificateResourceModel_resolvePEM(t *testing.T) {
+	testCert := generateTestCertificate(t, time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+	directory := t.TempDir()
+	certificatePath := filepath.Join(directory, "certificate.pem")
+	privateKeyPath := filepath.Join(directory, "private_key.pem")
+	require.NoError(t, os.WriteFile(certificatePath, []byte(testCert.certificate), 0o600))
+	require.NoError(t, os.WriteFile(privateKeyPath, []byte(testCert.privateKey), 0o600))
+
+	t.Run("files are read", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := loadBalancerListenerCertificateResourceModel{
+			Certificate:     basetypes.NewStringNull(),
+			PrivateKey:      basetypes.NewStringNull(),
+			Chain:           basetypes.NewStringValue(testCert.caCert),
+			CertificatePath: basetypes.NewStringValue(certificatePath),
+			PrivateKeyPath:  basetypes.NewStringValue(privateKeyPath),
+			ChainPath:       basetypes.NewStringNull(),
+		}.resolvePEM(&diags)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, testCert.certificate, got.Certificate.ValueString())
+		assert.Equal(t, testCert.privateKey, got.PrivateKey.ValueString())
+		assert.Equal(t, testCert.caCert, got.Chain.ValueString())
+	})
+
+	t.Run("missing file returns an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		loadBalancerListenerCertificateResourceModel{
+			Certificate:     basetypes.NewStringNull(),
+			PrivateKey:      basetypes.NewStringValue(testCert.privateKey),
+			CertificatePath: basetypes.NewStringValue(filepath.Join(directory, "missing.pem")),
+			PrivateKeyPath:  basetypes.NewStringNull(),
+			ChainPath:       basetypes.NewStringNull(),
+		}.resolvePEM(&diags)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Equal(t, "Unable to read file", diags.Errors()[0].Summary())
+	})
+}
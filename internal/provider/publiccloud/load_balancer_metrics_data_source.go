@@ -0,0 +1,167 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &loadBalancerMetricsDataSource{}
+)
+
+const (
+	loadBalancerMetricDataTraffic = "datatraffic"
+	loadBalancerMetricBandwidth   = "bandwidth"
+)
+
+var loadBalancerMetricsGranularities = map[string][]string{
+	loadBalancerMetricDataTraffic: utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedDataTrafficMetricsGranularityEnumValues),
+	loadBalancerMetricBandwidth:   utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedLoadBalancerMetricsGranularityEnumValues),
+}
+
+type loadBalancerMetricsDataSourceModel struct {
+	LoadBalancerID types.String                         `tfsdk:"load_balancer_id"`
+	Metric         types.String                         `tfsdk:"metric"`
+	From           types.String                         `tfsdk:"from"`
+	To             types.String                         `tfsdk:"to"`
+	Granularity    types.String                         `tfsdk:"granularity"`
+	Series         []utils.MetricsSeriesDataSourceModel `tfsdk:"series"`
+}
+
+type loadBalancerMetricsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (l *loadBalancerMetricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the load balancer.",
+			},
+			"metric": schema.StringAttribute{
+				Required:    true,
+				Description: "*bandwidth* returns the data transferred per second. Valid options are " + utils.StringTypeArrayToMarkdown([]string{loadBalancerMetricDataTraffic, loadBalancerMetricBandwidth}),
+				Validators: []validator.String{
+					stringvalidator.OneOf(loadBalancerMetricDataTraffic, loadBalancerMetricBandwidth),
+				},
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the interval, e.g. `2025-01-28T10:34:01+00:00`",
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the interval, e.g. `2025-01-28T11:44:01+00:00`",
+			},
+			"granularity": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Valid options for *%s* are %sValid options for *%s* are %s",
+					loadBalancerMetricDataTraffic,
+					utils.StringTypeArrayToMarkdown(loadBalancerMetricsGranularities[loadBalancerMetricDataTraffic]),
+					loadBalancerMetricBandwidth,
+					utils.StringTypeArrayToMarkdown(loadBalancerMetricsGranularities[loadBalancerMetricBandwidth]),
+				),
+			},
+			"series": utils.MetricsSeriesSchemaAttribute(metricsSeriesDescription),
+		},
+	}
+}
+
+func (l *loadBalancerMetricsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config loadBalancerMetricsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateMetricsGranularity(
+		config.Metric.ValueString(),
+		config.Granularity,
+		loadBalancerMetricsGranularities,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state := config
+	loadBalancerID := config.LoadBalancerID.ValueString()
+
+	switch config.Metric.ValueString() {
+	case loadBalancerMetricDataTraffic:
+		metricsRequest := l.PubliccloudAPI.GetLoadBalancerDataTrafficMetrics(ctx, loadBalancerID)
+		if !config.From.IsNull() {
+			metricsRequest = metricsRequest.From(config.From.ValueString())
+		}
+		if !config.To.IsNull() {
+			metricsRequest = metricsRequest.To(config.To.ValueString())
+		}
+		if !config.Granularity.IsNull() {
+			metricsRequest = metricsRequest.Granularity(config.Granularity.ValueString())
+		}
+
+		result, httpResponse, err := metricsRequest.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		metrics := result.GetMetrics()
+		state.Series = []utils.MetricsSeriesDataSourceModel{
+			adaptTrafficMetricToMetricsSeries("DOWN_PUBLIC", metrics.DownPublic),
+			adaptTrafficMetricToMetricsSeries("UP_PUBLIC", metrics.UpPublic),
+		}
+	case loadBalancerMetricBandwidth:
+		metricsRequest := l.PubliccloudAPI.GetDataTransferredPerSecondMetrics(ctx, loadBalancerID)
+		if !config.From.IsNull() {
+			metricsRequest = metricsRequest.From(config.From.ValueString())
+		}
+		if !config.To.IsNull() {
+			metricsRequest = metricsRequest.To(config.To.ValueString())
+		}
+		if !config.Granularity.IsNull() {
+			metricsRequest = metricsRequest.Granularity(config.Granularity.ValueString())
+		}
+
+		result, httpResponse, err := metricsRequest.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		metrics := result.GetMetrics()
+		state.Series = []utils.MetricsSeriesDataSourceModel{
+			adaptMetricsPropertiesToMetricsSeries("DATA_IN", metrics.DataIn),
+			adaptMetricsPropertiesToMetricsSeries("DATA_OUT", metrics.DataOut),
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewLoadBalancerMetricsDataSource() datasource.DataSource {
+	return &loadBalancerMetricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_load_balancer_metrics",
+		},
+	}
+}
@@ -3,7 +3,10 @@ package publiccloud
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -32,12 +35,14 @@ type loadBalancerIPResourceModel struct {
 }
 
 type loadBalancerResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Region    types.String `tfsdk:"region"`
-	Type      types.String `tfsdk:"type"`
-	Reference types.String `tfsdk:"reference"`
-	Contract  types.Object `tfsdk:"contract"`
-	IPs       types.List   `tfsdk:"ips"`
+	ID         types.String `tfsdk:"id"`
+	Region     types.String `tfsdk:"region"`
+	Type       types.String `tfsdk:"type"`
+	Reference  types.String `tfsdk:"reference"`
+	Contract   types.Object `tfsdk:"contract"`
+	IPs        types.List   `tfsdk:"ips"`
+	State      types.String `tfsdk:"state"`
+	PowerState types.String `tfsdk:"power_state"`
 }
 
 func adaptLoadBalancerDetailsToLoadBalancerResource(
@@ -46,10 +51,12 @@ func adaptLoadBalancerDetailsToLoadBalancerResource(
 	diags *diag.Diagnostics,
 ) *loadBalancerResourceModel {
 	loadBalancer := loadBalancerResourceModel{
-		ID:        basetypes.NewStringValue(loadBalancerDetails.GetId()),
-		Region:    basetypes.NewStringValue(string(loadBalancerDetails.GetRegion())),
-		Type:      basetypes.NewStringValue(string(loadBalancerDetails.GetType())),
-		Reference: basetypes.NewStringPointerValue(loadBalancerDetails.Reference.Get()),
+		ID:         basetypes.NewStringValue(loadBalancerDetails.GetId()),
+		Region:     basetypes.NewStringValue(string(loadBalancerDetails.GetRegion())),
+		Type:       basetypes.NewStringValue(string(loadBalancerDetails.GetType())),
+		Reference:  basetypes.NewStringPointerValue(loadBalancerDetails.Reference.Get()),
+		State:      basetypes.NewStringValue(string(loadBalancerDetails.GetState())),
+		PowerState: basetypes.NewStringValue(adaptStateToPowerState(loadBalancerDetails.GetState())),
 	}
 
 	contract := utils.AdaptSdkModelToResourceObject(
@@ -91,6 +98,109 @@ func adaptIpDetailsToLoadBalancerIPResource(ipDetails publiccloud.IpDetails) loa
 	}
 }
 
+const (
+	powerStateRunning = "RUNNING"
+	powerStateStopped = "STOPPED"
+)
+
+func adaptStateToPowerState(state publiccloud.State) string {
+	if state == publiccloud.STATE_STOPPED || state == publiccloud.STATE_STOPPING {
+		return powerStateStopped
+	}
+
+	return powerStateRunning
+}
+
+// settledLoadBalancerStates are the states in which a load balancer is no
+// longer being created, started, stopped or updated.
+var settledLoadBalancerStates = []publiccloud.State{
+	publiccloud.STATE_RUNNING,
+	publiccloud.STATE_STOPPED,
+}
+
+// waitForLoadBalancerState polls the load balancer until it reaches one of
+// the passed states. A load balancer that has FAILED never recovers.
+func waitForLoadBalancerState(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	id string,
+	states []publiccloud.State,
+	diags *diag.Diagnostics,
+) *publiccloud.LoadBalancerDetails {
+	pollState := func() (*publiccloud.LoadBalancerDetails, error) {
+		loadBalancerDetails, httpResponse, err := api.
+			GetLoadBalancer(ctx, id).
+			Execute()
+		if err != nil {
+			// A destroyed load balancer might not be returned anymore.
+			if httpResponse != nil &&
+				httpResponse.StatusCode == http.StatusNotFound &&
+				slices.Contains(states, publiccloud.STATE_DESTROYED) {
+				return nil, nil
+			}
+
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return nil, backoff.Permanent(err)
+		}
+
+		state := loadBalancerDetails.GetState()
+		if slices.Contains(states, state) {
+			return loadBalancerDetails, nil
+		}
+		if state == publiccloud.STATE_FAILED {
+			return nil, backoff.Permanent(
+				fmt.Errorf("load balancer %s has state %s", id, state),
+			)
+		}
+
+		return nil, fmt.Errorf("load balancer %s has state %s", id, state)
+	}
+
+	loadBalancerDetails, err := backoff.Retry(
+		ctx,
+		pollState,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
+
+	return loadBalancerDetails
+}
+
+// changeLoadBalancerPowerState starts or stops the load balancer and waits
+// until it is done.
+func changeLoadBalancerPowerState(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	id string,
+	powerState string,
+	diags *diag.Diagnostics,
+) *publiccloud.LoadBalancerDetails {
+	var httpResponse *http.Response
+	var err error
+	desiredState := publiccloud.STATE_RUNNING
+
+	if powerState == powerStateStopped {
+		desiredState = publiccloud.STATE_STOPPED
+		httpResponse, err = api.StopLoadBalancer(ctx, id).Execute()
+	} else {
+		httpResponse, err = api.StartLoadBalancer(ctx, id).Execute()
+	}
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return nil
+	}
+
+	return waitForLoadBalancerState(
+		ctx,
+		api,
+		id,
+		[]publiccloud.State{desiredState},
+		diags,
+	)
+}
+
 type loadBalancerResource struct {
 	utils.ResourceAPI
 }
@@ -178,6 +288,21 @@ func (l *loadBalancerResource) Schema(
 					},
 				},
 			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The load balancer's current state",
+			},
+			"power_state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Stop the load balancer to park idle environments. Valid options are " + utils.StringTypeArrayToMarkdown([]string{powerStateRunning, powerStateStopped}),
+				Validators: []validator.String{
+					stringvalidator.OneOf(powerStateRunning, powerStateStopped),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"region": schema.StringAttribute{
 				Required: true,
 				Description: fmt.Sprintf(
@@ -245,7 +370,9 @@ func (l *loadBalancerResource) Create(
 		return
 	}
 
-	state := adaptLoadBalancerDetailsToLoadBalancerResource(
+	// The load balancer exists at this point, so it has to end up in the
+	// state even if it never starts.
+	launchedState := adaptLoadBalancerDetailsToLoadBalancerResource(
 		*loadBalancer,
 		ctx,
 		&response.Diagnostics,
@@ -253,6 +380,43 @@ func (l *loadBalancerResource) Create(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	response.Diagnostics.Append(response.State.Set(ctx, launchedState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	loadBalancerDetails := waitForLoadBalancerState(
+		ctx,
+		l.PubliccloudAPI,
+		loadBalancer.GetId(),
+		[]publiccloud.State{publiccloud.STATE_RUNNING},
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PowerState.ValueString() == powerStateStopped {
+		loadBalancerDetails = changeLoadBalancerPowerState(
+			ctx,
+			l.PubliccloudAPI,
+			loadBalancer.GetId(),
+			powerStateStopped,
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state := adaptLoadBalancerDetailsToLoadBalancerResource(
+		*loadBalancerDetails,
+		ctx,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -299,13 +463,19 @@ func (l *loadBalancerResource) Update(
 		return
 	}
 
+	var currentState loadBalancerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	opts := publiccloud.NewUpdateLoadBalancerOpts()
 	opts.Reference = utils.AdaptStringPointerValueToNullableString(plan.Reference)
 	if plan.Type.ValueString() != "" {
 		opts.SetType(publiccloud.TypeName(plan.Type.ValueString()))
 	}
 
-	loadBalancerDetails, httpResponse, err := l.PubliccloudAPI.
+	_, httpResponse, err := l.PubliccloudAPI.
 		UpdateLoadBalancer(ctx, plan.ID.ValueString()).
 		UpdateLoadBalancerOpts(*opts).
 		Execute()
@@ -313,6 +483,32 @@ func (l *loadBalancerResource) Update(
 		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
 		return
 	}
+
+	loadBalancerDetails := waitForLoadBalancerState(
+		ctx,
+		l.PubliccloudAPI,
+		plan.ID.ValueString(),
+		settledLoadBalancerStates,
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PowerState.IsUnknown() &&
+		plan.PowerState.ValueString() != adaptStateToPowerState(loadBalancerDetails.GetState()) {
+		loadBalancerDetails = changeLoadBalancerPowerState(
+			ctx,
+			l.PubliccloudAPI,
+			plan.ID.ValueString(),
+			plan.PowerState.ValueString(),
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	state := adaptLoadBalancerDetailsToLoadBalancerResource(
 		*loadBalancerDetails,
 		ctx,
@@ -342,7 +538,16 @@ func (l *loadBalancerResource) Delete(
 	).Execute()
 	if err != nil {
 		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
 	}
+
+	waitForLoadBalancerState(
+		ctx,
+		l.PubliccloudAPI,
+		state.ID.ValueString(),
+		[]publiccloud.State{publiccloud.STATE_DESTROYED},
+		&response.Diagnostics,
+	)
 }
 
 func NewLoadBalancerResource() resource.Resource {
@@ -20,6 +20,7 @@ func Test_adaptLoadBalancerDetailsToLoadBalancerResource(t *testing.T) {
 			Contract: publiccloud.Contract{
 				Type: publiccloud.CONTRACTTYPE_MONTHLY,
 			},
+			State: publiccloud.STATE_STOPPED,
 		}
 
 		diags := diag.Diagnostics{}
@@ -35,6 +36,8 @@ func Test_adaptLoadBalancerDetailsToLoadBalancerResource(t *testing.T) {
 		assert.Equal(t, "region", got.Region.ValueString())
 		assert.Equal(t, "lsw.c3.2xlarge", got.Type.ValueString())
 		assert.Nil(t, got.Reference.ValueStringPointer())
+		assert.Equal(t, "STOPPED", got.State.ValueString())
+		assert.Equal(t, "STOPPED", got.PowerState.ValueString())
 
 		contract := contractResourceModel{}
 		got.Contract.As(context.TODO(), &contract, basetypes.ObjectAsOptions{})
@@ -99,3 +102,15 @@ func Test_adaptIpDetailsToLoadBalancerIPResource(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 }
+
+func Test_adaptStateToPowerState(t *testing.T) {
+	t.Run("stopped load balancers are STOPPED", func(t *testing.T) {
+		assert.Equal(t, "STOPPED", adaptStateToPowerState(publiccloud.STATE_STOPPED))
+		assert.Equal(t, "STOPPED", adaptStateToPowerState(publiccloud.STATE_STOPPING))
+	})
+
+	t.Run("other load balancers are RUNNING", func(t *testing.T) {
+		assert.Equal(t, "RUNNING", adaptStateToPowerState(publiccloud.STATE_RUNNING))
+		assert.Equal(t, "RUNNING", adaptStateToPowerState(publiccloud.STATE_STARTING))
+	})
+}
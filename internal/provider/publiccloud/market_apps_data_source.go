@@ -0,0 +1,118 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &marketAppsDataSource{}
+)
+
+type marketAppDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Category types.String `tfsdk:"category"`
+	Version  types.String `tfsdk:"version"`
+	Family   types.String `tfsdk:"family"`
+	ImageID  types.String `tfsdk:"image_id"`
+}
+
+func adaptMarketAppToMarketAppDataSource(marketApp publiccloud.MarketApp) marketAppDataSourceModel {
+	var imageID *string
+	if image, ok := marketApp.GetImageOk(); ok {
+		imageID = &image.Id
+	}
+
+	return marketAppDataSourceModel{
+		ID:       basetypes.NewStringPointerValue(marketApp.Id),
+		Name:     basetypes.NewStringPointerValue(marketApp.Name),
+		Category: basetypes.NewStringPointerValue(marketApp.Category),
+		Version:  basetypes.NewStringPointerValue(marketApp.Version.Get()),
+		Family:   basetypes.NewStringPointerValue(marketApp.Family),
+		ImageID:  basetypes.NewStringPointerValue(imageID),
+	}
+}
+
+type marketAppsDataSourceModel struct {
+	MarketApps []marketAppDataSourceModel `tfsdk:"market_apps"`
+}
+
+type marketAppsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (m *marketAppsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"market_apps": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Can be used as `market_app_id` when launching an instance",
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"category": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+						"family": schema.StringAttribute{
+							Computed: true,
+						},
+						"image_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The image the market app is installed on",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m *marketAppsDataSource) Read(
+	ctx context.Context,
+	_ datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	result, httpResponse, err := m.PubliccloudAPI.GetMarketAppList(ctx).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	var state marketAppsDataSourceModel
+	for _, marketApp := range result.GetMarketApps() {
+		state.MarketApps = append(
+			state.MarketApps,
+			adaptMarketAppToMarketAppDataSource(marketApp),
+		)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewMarketAppsDataSource() datasource.DataSource {
+	return &marketAppsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_market_apps",
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptMarketAppToMarketAppDataSource(t *testing.T) {
+	t.Run("all fields are set", func(t *testing.T) {
+		id := "CPANEL_30"
+		name := "cPanel 30"
+		version := "30"
+		marketApp := publiccloud.MarketApp{
+			Id:      &id,
+			Name:    &name,
+			Version: *publiccloud.NewNullableString(&version),
+			Image:   &publiccloud.Image{Id: "ALMALINUX_8_64BIT"},
+		}
+
+		got := adaptMarketAppToMarketAppDataSource(marketApp)
+
+		assert.Equal(t, "CPANEL_30", got.ID.ValueString())
+		assert.Equal(t, "cPanel 30", got.Name.ValueString())
+		assert.Equal(t, "30", got.Version.ValueString())
+		assert.Equal(t, "ALMALINUX_8_64BIT", got.ImageID.ValueString())
+		assert.True(t, got.Category.IsNull())
+	})
+
+	t.Run("image_id is null without an image", func(t *testing.T) {
+		got := adaptMarketAppToMarketAppDataSource(publiccloud.MarketApp{})
+
+		assert.True(t, got.ImageID.IsNull())
+	})
+}
@@ -0,0 +1,109 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &regionsDataSource{}
+)
+
+type regionDataSourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Location types.String `tfsdk:"location"`
+}
+
+func adaptRegionToRegionDataSource(region publiccloud.Region) regionDataSourceModel {
+	return regionDataSourceModel{
+		Name:     basetypes.NewStringValue(string(region.GetName())),
+		Location: basetypes.NewStringValue(region.GetLocation()),
+	}
+}
+
+type regionsDataSourceModel struct {
+	Regions []regionDataSourceModel `tfsdk:"regions"`
+}
+
+type regionsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (r *regionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "The city where the region is located",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *regionsDataSource) Read(
+	ctx context.Context,
+	_ datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var regions []publiccloud.Region
+	var offset *int32
+
+	request := r.PubliccloudAPI.GetRegionList(ctx)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		regions = append(regions, result.GetRegions()...)
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			break
+		}
+
+		request = request.Offset(*offset)
+	}
+
+	var state regionsDataSourceModel
+	for _, region := range regions {
+		state.Regions = append(state.Regions, adaptRegionToRegionDataSource(region))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_regions",
+		},
+	}
+}
@@ -2,7 +2,11 @@ package publiccloud
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -12,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,13 +30,111 @@ var (
 	_ resource.ResourceWithImportState = &targetGroupResource{}
 )
 
+var durationRegexp = regexp.MustCompile(`^(\d+[hms])+$`)
+
 type targetGroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Protocol    types.String `tfsdk:"protocol"`
-	Port        types.Int32  `tfsdk:"port"`
-	Region      types.String `tfsdk:"region"`
-	HealthCheck types.Object `tfsdk:"health_check"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Protocol       types.String `tfsdk:"protocol"`
+	Port           types.Int32  `tfsdk:"port"`
+	Region         types.String `tfsdk:"region"`
+	HealthCheck    types.Object `tfsdk:"health_check"`
+	WaitForHealthy types.Object `tfsdk:"wait_for_healthy"`
+}
+
+type waitForHealthyResourceModel struct {
+	MinimumHealthyTargets types.Int32  `tfsdk:"minimum_healthy_targets"`
+	Timeout               types.String `tfsdk:"timeout"`
+}
+
+func (w waitForHealthyResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"minimum_healthy_targets": types.Int32Type,
+		"timeout":                 types.StringType,
+	}
+}
+
+// countHealthyTargets returns the number of targets that pass the health check.
+func countHealthyTargets(targets []publiccloud.Target) int32 {
+	healthyTargets := int32(0)
+	for _, target := range targets {
+		healthCheck, _ := target.GetHealthCheckOk()
+		if healthCheck != nil &&
+			healthCheck.GetState() == publiccloud.HEALTHCHECKSTATUS_HEALTHY {
+			healthyTargets++
+		}
+	}
+
+	return healthyTargets
+}
+
+// waitForHealthyTargets polls the targets of the target group until at
+// least minimumHealthyTargets of them pass the health check.
+func waitForHealthyTargets(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	targetGroupID string,
+	waitForHealthy waitForHealthyResourceModel,
+	diags *diag.Diagnostics,
+) {
+	timeout, err := time.ParseDuration(waitForHealthy.Timeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("wait_for_healthy").AtName("timeout"),
+			"Invalid Attribute Value",
+			err.Error(),
+		)
+		return
+	}
+	minimumHealthyTargets := waitForHealthy.MinimumHealthyTargets.ValueInt32()
+
+	pollTargets := func() (int32, error) {
+		var targets []publiccloud.Target
+		targetsRequest := api.GetTargetList(ctx, targetGroupID)
+		for {
+			result, httpResponse, err := targetsRequest.Execute()
+			if err != nil {
+				utils.SdkError(ctx, diags, err, httpResponse)
+				return 0, backoff.Permanent(err)
+			}
+
+			targets = append(targets, result.GetTargets()...)
+
+			metadata := result.GetMetadata()
+			offset := utils.NewOffset(
+				metadata.GetLimit(),
+				metadata.GetOffset(),
+				metadata.GetTotalCount(),
+			)
+			if offset == nil {
+				break
+			}
+
+			targetsRequest = targetsRequest.Offset(*offset)
+		}
+
+		healthyTargets := countHealthyTargets(targets)
+		if healthyTargets < minimumHealthyTargets {
+			return healthyTargets, fmt.Errorf(
+				"%d of the required %d targets of target group %s are healthy",
+				healthyTargets,
+				minimumHealthyTargets,
+				targetGroupID,
+			)
+		}
+
+		return healthyTargets, nil
+	}
+
+	_, err = backoff.Retry(
+		ctx,
+		pollTargets,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+		backoff.WithMaxElapsedTime(timeout),
+	)
+	if err != nil && !diags.HasError() {
+		utils.GeneralError(diags, ctx, err)
+	}
 }
 
 func adaptTargetGroupToTargetGroupResource(
@@ -45,6 +148,9 @@ func adaptTargetGroupToTargetGroupResource(
 		Protocol: basetypes.NewStringValue(string(sdkTargetGroup.GetProtocol())),
 		Port:     basetypes.NewInt32Value(sdkTargetGroup.GetPort()),
 		Region:   basetypes.NewStringValue(string(sdkTargetGroup.GetRegion())),
+		WaitForHealthy: basetypes.NewObjectNull(
+			waitForHealthyResourceModel{}.attributeTypes(),
+		),
 	}
 
 	sdkHealthCheck, _ := sdkTargetGroup.GetHealthCheckOk()
@@ -171,6 +277,28 @@ func (t *targetGroupResource) Schema(
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"wait_for_healthy": schema.SingleNestedAttribute{
+				Description: "Wait for the targets to pass the health check after the target group is updated. New target groups have no targets, so nothing is waited for on creation.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"minimum_healthy_targets": schema.Int32Attribute{
+						Required:    true,
+						Description: "The minimum number of healthy targets to wait for",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"timeout": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "How long to wait for the targets to become healthy, e.g. `30s` or `1h30m`. Defaults to `10m`",
+						Default:     stringdefault.StaticString("10m"),
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegexp, "must be a duration, i.e.: 10m"),
+						},
+					},
+				},
+			},
 			"health_check": schema.SingleNestedAttribute{
 				Description: "**WARNING!** Removing health_check once running will cause this target group to be destroyed and a new one to be created.",
 				Optional:    true,
@@ -274,6 +402,7 @@ func (t *targetGroupResource) Create(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	targetGroup.WaitForHealthy = plan.WaitForHealthy
 
 	response.Diagnostics.Append(response.State.Set(ctx, targetGroup)...)
 }
@@ -305,6 +434,8 @@ func (t *targetGroupResource) Read(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	// wait_for_healthy is not returned by the API.
+	targetGroup.WaitForHealthy = state.WaitForHealthy
 
 	response.Diagnostics.Append(response.State.Set(ctx, targetGroup)...)
 }
@@ -355,8 +486,32 @@ func (t *targetGroupResource) Update(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	targetGroup.WaitForHealthy = plan.WaitForHealthy
 
+	// The target group has been updated at this point, so the state must be
+	// kept even if the targets do not become healthy in time.
 	response.Diagnostics.Append(response.State.Set(ctx, targetGroup)...)
+	if response.Diagnostics.HasError() || plan.WaitForHealthy.IsNull() {
+		return
+	}
+
+	waitForHealthy := waitForHealthyResourceModel{}
+	response.Diagnostics.Append(plan.WaitForHealthy.As(
+		ctx,
+		&waitForHealthy,
+		basetypes.ObjectAsOptions{},
+	)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	waitForHealthyTargets(
+		ctx,
+		t.PubliccloudAPI,
+		plan.ID.ValueString(),
+		waitForHealthy,
+		&response.Diagnostics,
+	)
 }
 
 func (t *targetGroupResource) Delete(
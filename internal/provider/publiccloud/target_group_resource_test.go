@@ -87,6 +87,12 @@ func Test_adaptTargetGroupToTargetGroupResource(t *testing.T) {
 					"port":     types.Int32Type,
 				},
 			),
+			WaitForHealthy: basetypes.NewObjectNull(
+				map[string]attr.Type{
+					"minimum_healthy_targets": types.Int32Type,
+					"timeout":                 types.StringType,
+				},
+			),
 		}
 
 		assert.False(t, diags.HasError())
@@ -117,3 +123,23 @@ func Test_adaptTargetGroupToTargetGroupResource(t *testing.T) {
 		assert.Equal(t, "HTTP", got.Protocol.ValueString())
 	})
 }
+
+func Test_countHealthyTargets(t *testing.T) {
+	newTarget := func(state publiccloud.HealthCheckStatus) publiccloud.Target {
+		return publiccloud.Target{
+			HealthCheck: *publiccloud.NewNullableSchemasHealthCheckStatus(
+				&publiccloud.SchemasHealthCheckStatus{State: state},
+			),
+		}
+	}
+
+	got := countHealthyTargets([]publiccloud.Target{
+		newTarget(publiccloud.HEALTHCHECKSTATUS_HEALTHY),
+		newTarget(publiccloud.HEALTHCHECKSTATUS_UNHEALTHY),
+		newTarget(publiccloud.HEALTHCHECKSTATUS_HEALTHY),
+		newTarget(publiccloud.HEALTHCHECKSTATUS_MAINTENANCE),
+		{},
+	})
+
+	assert.Equal(t, int32(2), got)
+}
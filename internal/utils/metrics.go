@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"math"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MetricsValueDataSourceModel is a single value of a metrics series.
+type MetricsValueDataSourceModel struct {
+	Timestamp types.String  `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+// MetricsSeriesDataSourceModel is a metrics series including the
+// aggregates of its values.
+type MetricsSeriesDataSourceModel struct {
+	Name         types.String                  `tfsdk:"name"`
+	Unit         types.String                  `tfsdk:"unit"`
+	Values       []MetricsValueDataSourceModel `tfsdk:"values"`
+	Min          types.Float64                 `tfsdk:"min"`
+	Max          types.Float64                 `tfsdk:"max"`
+	Avg          types.Float64                 `tfsdk:"avg"`
+	Percentile95 types.Float64                 `tfsdk:"percentile_95"`
+}
+
+// NewMetricsSeries calculates the aggregates of the passed values.
+// Aggregates are null if there are no values.
+func NewMetricsSeries(
+	name string,
+	unit types.String,
+	values []MetricsValueDataSourceModel,
+) MetricsSeriesDataSourceModel {
+	series := MetricsSeriesDataSourceModel{
+		Name:         basetypes.NewStringValue(name),
+		Unit:         unit,
+		Values:       values,
+		Min:          basetypes.NewFloat64Null(),
+		Max:          basetypes.NewFloat64Null(),
+		Avg:          basetypes.NewFloat64Null(),
+		Percentile95: basetypes.NewFloat64Null(),
+	}
+
+	if len(values) == 0 {
+		return series
+	}
+
+	sorted := make([]float64, 0, len(values))
+	total := float64(0)
+	for _, value := range values {
+		sorted = append(sorted, value.Value.ValueFloat64())
+		total += value.Value.ValueFloat64()
+	}
+	slices.Sort(sorted)
+
+	// The 95th percentile uses the nearest-rank method, the same way
+	// 95th percentile billing is calculated.
+	rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+
+	series.Min = basetypes.NewFloat64Value(sorted[0])
+	series.Max = basetypes.NewFloat64Value(sorted[len(sorted)-1])
+	series.Avg = basetypes.NewFloat64Value(total / float64(len(sorted)))
+	series.Percentile95 = basetypes.NewFloat64Value(sorted[rank])
+
+	return series
+}
+
+// MetricsSeriesSchemaAttribute returns the schema of a list of
+// MetricsSeriesDataSourceModel.
+func MetricsSeriesSchemaAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:    true,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"unit": schema.StringAttribute{
+					Computed: true,
+				},
+				"values": schema.ListNestedAttribute{
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"timestamp": schema.StringAttribute{
+								Computed: true,
+							},
+							"value": schema.Float64Attribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+				"min": schema.Float64Attribute{
+					Computed:    true,
+					Description: "The lowest value in `values`",
+				},
+				"max": schema.Float64Attribute{
+					Computed:    true,
+					Description: "The highest value in `values`",
+				},
+				"avg": schema.Float64Attribute{
+					Computed:    true,
+					Description: "The average of `values`",
+				},
+				"percentile_95": schema.Float64Attribute{
+					Computed:    true,
+					Description: "The 95th percentile of `values`",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMetricsSeries(t *testing.T) {
+	// newValues returns the values n to 1, so they have to be sorted.
+	newValues := func(n int) []MetricsValueDataSourceModel {
+		var values []MetricsValueDataSourceModel
+		for i := n; i > 0; i-- {
+			values = append(values, MetricsValueDataSourceModel{
+				Timestamp: basetypes.NewStringValue("2025-01-28T00:00:00Z"),
+				Value:     basetypes.NewFloat64Value(float64(i)),
+			})
+		}
+
+		return values
+	}
+
+	tests := []struct {
+		name             string
+		values           []MetricsValueDataSourceModel
+		wantMin          basetypes.Float64Value
+		wantMax          basetypes.Float64Value
+		wantAvg          basetypes.Float64Value
+		wantPercentile95 basetypes.Float64Value
+	}{
+		{
+			name:             "aggregates are null without values",
+			values:           nil,
+			wantMin:          basetypes.NewFloat64Null(),
+			wantMax:          basetypes.NewFloat64Null(),
+			wantAvg:          basetypes.NewFloat64Null(),
+			wantPercentile95: basetypes.NewFloat64Null(),
+		},
+		{
+			name:             "aggregates of a single value",
+			values:           newValues(1),
+			wantMin:          basetypes.NewFloat64Value(1),
+			wantMax:          basetypes.NewFloat64Value(1),
+			wantAvg:          basetypes.NewFloat64Value(1),
+			wantPercentile95: basetypes.NewFloat64Value(1),
+		},
+		{
+			name:             "95th percentile of 20 values is the 19th value",
+			values:           newValues(20),
+			wantMin:          basetypes.NewFloat64Value(1),
+			wantMax:          basetypes.NewFloat64Value(20),
+			wantAvg:          basetypes.NewFloat64Value(10.5),
+			wantPercentile95: basetypes.NewFloat64Value(19),
+		},
+		{
+			name:             "95th percentile of 21 values is the 20th value",
+			values:           newValues(21),
+			wantMin:          basetypes.NewFloat64Value(1),
+			wantMax:          basetypes.NewFloat64Value(21),
+			wantAvg:          basetypes.NewFloat64Value(11),
+			wantPercentile95: basetypes.NewFloat64Value(20),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMetricsSeries(
+				"DOWN_PUBLIC",
+				basetypes.NewStringValue("B"),
+				tt.values,
+			)
+
+			assert.Equal(t, basetypes.NewStringValue("DOWN_PUBLIC"), got.Name)
+			assert.Equal(t, basetypes.NewStringValue("B"), got.Unit)
+			assert.Equal(t, tt.values, got.Values)
+			assert.Equal(t, tt.wantMin, got.Min)
+			assert.Equal(t, tt.wantMax, got.Max)
+			assert.Equal(t, tt.wantAvg, got.Avg)
+			assert.Equal(t, tt.wantPercentile95, got.Percentile95)
+		})
+	}
+
+	t.Run("unit can be null", func(t *testing.T) {
+		got := NewMetricsSeries("DOWN_PUBLIC", basetypes.NewStringNull(), nil)
+
+		assert.True(t, got.Unit.IsNull())
+	})
+}
@@ -35,6 +35,17 @@ func AdaptNullableTimeToStringValue(value *time.Time) basetypes.StringValue {
 	return basetypes.NewStringValue(value.String())
 }
 
+// AdaptNullableTimeToRFC3339StringValue converts a nullable Time to a
+// Terraform StringValue formatted as RFC3339 in UTC, the same way times are
+// configured so that the plan & state do not drift.
+func AdaptNullableTimeToRFC3339StringValue(value *time.Time) basetypes.StringValue {
+	if value == nil {
+		return basetypes.NewStringNull()
+	}
+
+	return basetypes.NewStringValue(value.UTC().Format(time.RFC3339))
+}
+
 // AdaptSdkModelToResourceObject converts an sdk model to a Terraform resource object.
 func AdaptSdkModelToResourceObject[T any, U any](
 	sdkModel T,
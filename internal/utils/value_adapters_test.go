@@ -91,6 +91,37 @@ func TestAdaptNullableTimeToStringValue(t *testing.T) {
 	}
 }
 
+func TestAdaptNullableTimeToRFC3339StringValue(t *testing.T) {
+	value, _ := time.Parse(time.RFC3339, "2019-09-08T02:00:00+02:00")
+
+	type args struct {
+		value *time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want basetypes.StringValue
+	}{
+		{
+			name: "time is not set",
+			args: args{value: nil},
+			want: basetypes.NewStringNull(),
+		},
+		{
+			name: "time is set",
+			args: args{value: &value},
+			want: basetypes.NewStringValue("2019-09-08T00:00:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, AdaptNullableTimeToRFC3339StringValue(
+				tt.args.value,
+			), "AdaptNullableTimeToRFC3339StringValue(%v)", tt.args.value)
+		})
+	}
+}
+
 func TestAdaptDomainEntityToResourceObject(t *testing.T) {
 	entity := mockDomainEntity{}
 
@@ -213,6 +244,14 @@ func ExampleAdaptNullableTimeToStringValue_second() {
 	// Output: <null>
 }
 
+func ExampleAdaptNullableTimeToRFC3339StringValue() {
+	nullableTime, _ := time.Parse(time.RFC3339, "2019-09-08T02:00:00+02:00")
+	value := AdaptNullableTimeToRFC3339StringValue(&nullableTime)
+
+	fmt.Println(value)
+	// Output: "2019-09-08T00:00:00Z"
+}
+
 func ExampleAdaptSdkModelToResourceObject() {
 	type Image struct {
 		Id types.String `tfsdk:"id"`